@@ -2,12 +2,14 @@ module github.com/lucasvidela94/workflow-cli
 
 go 1.24.5
 
-require github.com/spf13/cobra v1.9.1
+require (
+	github.com/google/go-github/v62 v62.0.0
+	github.com/mattn/go-sqlite3 v1.14.28
+	github.com/spf13/cobra v1.9.1
+)
 
 require (
-	github.com/google/go-github/v62 v62.0.0 // indirect
 	github.com/google/go-querystring v1.1.0 // indirect
 	github.com/inconshreveable/mousetrap v1.1.0 // indirect
-	github.com/mattn/go-sqlite3 v1.14.28 // indirect
 	github.com/spf13/pflag v1.0.6 // indirect
 )
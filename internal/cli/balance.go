@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+// Agrupaciones del desglose del saldo de horas
+const (
+	balanceByDay  = "day"
+	balanceByWeek = "week"
+)
+
+// balanceCmd muestra el saldo de horas (flexitime)
+var balanceCmd = &cobra.Command{
+	Use:   "balance",
+	Short: "Show the flexitime balance (worked minus expected hours)",
+	Long: `Show the running balance of worked hours minus the hours expected by the work
+calendar, taking holidays and leave into account. Totals are shown for this week,
+this month and since the balance start date, followed by a breakdown.
+
+The balance start date defaults to January 1st of the current year; set it once with
+'workflow balance start <date>'.
+
+Examples:
+  workflow balance
+  workflow balance --by day --from mon
+  workflow balance --from 2025-01-01 --to 2025-06-30
+  workflow balance start 2025-03-01
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fromFlag, _ := cmd.Flags().GetString("from")
+		toFlag, _ := cmd.Flags().GetString("to")
+		by, _ := cmd.Flags().GetString("by")
+
+		if by != balanceByDay && by != balanceByWeek {
+			printError(fmt.Errorf("invalid --by value: %s (use day or week)", by))
+			return
+		}
+
+		start := balanceStart()
+		today := core.Today()
+
+		from, err := parseDateFlag(fromFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
+		if from == "" {
+			from = start
+		}
+		to, err := parseDateFlag(toFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
+		if to == "" {
+			to = today
+		}
+		if from > to {
+			printError(fmt.Errorf("--from (%s) must not be after --to (%s)", from, to))
+			return
+		}
+
+		fmt.Println("⚖️  Flexitime balance:")
+		for _, total := range []struct {
+			label string
+			from  string
+		}{
+			{"This week", getWeekStart()},
+			{"This month", getMonthStart()},
+			{"Since " + start, start},
+		} {
+			if total.from > today {
+				continue
+			}
+			days, err := loadBalance(total.from, today)
+			if err != nil {
+				printError(err)
+				return
+			}
+			sum := core.SumBalance(days)
+			fmt.Printf("  %-18s %+.2fh (worked %.2fh / expected %.2fh)\n", total.label+":", sum.Balance(), sum.Worked, sum.Expected)
+		}
+
+		days, err := loadBalance(from, to)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		fmt.Printf("\n📅 By %s (%s to %s):\n", by, from, to)
+		running := 0.0
+		for _, group := range groupBalance(days, by) {
+			running += group.Balance()
+			label := group.Date
+			if by == balanceByDay {
+				if holiday, isHoliday := workCalendar().Holiday(group.Date); isHoliday {
+					label += " " + holiday
+				}
+			}
+			fmt.Printf("  %-12s worked %6.2fh  expected %6.2fh  %+7.2fh  (running %+.2fh)\n",
+				label, group.Worked, group.Expected, group.Balance(), running)
+		}
+	},
+}
+
+// balanceStartCmd fija la fecha desde la que se acumula el saldo
+var balanceStartCmd = &cobra.Command{
+	Use:   "start <date>",
+	Short: "Set the date the running balance starts from",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		date, err := parseDateFlag(args[0])
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		configManager := core.LoadConfigManager()
+		if err := configManager.SetBalanceStart(date); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Balance now accumulates from %s", date))
+	},
+}
+
+// balanceStart devuelve la fecha configurada de inicio del saldo o el 1 de enero del año actual
+func balanceStart() string {
+	if start := core.LoadConfigManager().GetBalanceStart(); start != "" {
+		return start
+	}
+	return fmt.Sprintf("%04d-01-01", core.Now().Year())
+}
+
+// loadBalance calcula el saldo de cada día entre dos fechas con todas las tareas trabajadas
+func loadBalance(from string, to string) ([]core.DayBalance, error) {
+	taskManager := core.NewTaskStore()
+	defer taskManager.Close()
+
+	tasks, err := taskManager.SearchTasksInRange(core.TaskFilter{From: from, To: to})
+	if err != nil {
+		return nil, err
+	}
+
+	return core.DailyBalance(tasks, calendarWithLeave(from, to), from, to)
+}
+
+// groupBalance agrupa los saldos diarios por día o por semana del calendario laboral
+func groupBalance(days []core.DayBalance, by string) []core.DayBalance {
+	if by == balanceByDay {
+		return days
+	}
+
+	calendar := workCalendar()
+	var groups []core.DayBalance
+	var current []core.DayBalance
+	currentWeek := ""
+
+	for _, day := range days {
+		week := day.Date
+		if t, err := time.Parse("2006-01-02", day.Date); err == nil {
+			week = calendar.StartOfWeek(t).Format("2006-01-02")
+		}
+
+		if week != currentWeek && len(current) > 0 {
+			groups = append(groups, core.SumBalance(current))
+			current = nil
+		}
+		currentWeek = week
+		current = append(current, day)
+	}
+	if len(current) > 0 {
+		groups = append(groups, core.SumBalance(current))
+	}
+
+	return groups
+}
+
+// runningBalance devuelve la fecha de inicio y el saldo acumulado hasta una fecha (como mucho hoy).
+// Devuelve una fecha vacía si el período termina antes del inicio del saldo.
+func runningBalance(to string) (string, float64) {
+	start := balanceStart()
+	if to > core.Today() {
+		to = core.Today()
+	}
+	if start > to {
+		return "", 0
+	}
+
+	days, err := loadBalance(start, to)
+	if err != nil {
+		printError(err)
+		return "", 0
+	}
+	return start, core.SumBalance(days).Balance()
+}
+
+func init() {
+	balanceCmd.Flags().String("from", "", "Start of the breakdown ("+dateFlagHelp+"; default: balance start date)")
+	balanceCmd.Flags().String("to", "", "End of the breakdown ("+dateFlagHelp+"; default: today)")
+	balanceCmd.Flags().String("by", balanceByWeek, "Group the breakdown by day or week")
+
+	balanceCmd.AddCommand(balanceStartCmd)
+}
@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// selectionHelp describe cómo seleccionar varias tareas en los comandos masivos
+const selectionHelp = `Tasks can be selected by ID (1 2 3), by range (12-18), or with the same
+filters as 'search': --filter-text, --filter-date, --filter-from, --filter-to,
+--filter-category, --filter-status, --filter-tag and --filter-any-tag.`
+
+// addSelectionFlags agrega los flags para seleccionar tareas por filtros
+func addSelectionFlags(cmd *cobra.Command) {
+	cmd.Flags().String("filter-text", "", "Select tasks whose description contains this text")
+	cmd.Flags().String("filter-date", "", "Select tasks worked on this date or week ("+dateRangeFlagHelp+")")
+	cmd.Flags().String("filter-from", "", "Select tasks worked on or after this date ("+dateRangeFlagHelp+")")
+	cmd.Flags().String("filter-to", "", "Select tasks worked on or before this date ("+dateRangeFlagHelp+")")
+	cmd.Flags().String("filter-category", "", "Select tasks in this category")
+	cmd.Flags().String("filter-status", "", "Select tasks with this status")
+	cmd.Flags().StringArray("filter-tag", nil, "Select tasks with this tag (repeatable, all must match)")
+	cmd.Flags().StringArray("filter-any-tag", nil, "Select tasks with any of these tags (repeatable)")
+}
+
+// selectTasks resuelve las tareas indicadas por IDs, rangos de IDs o flags de filtro
+func selectTasks(cmd *cobra.Command, args []string, taskManager core.TaskStore) ([]workflow.Task, error) {
+	text, _ := cmd.Flags().GetString("filter-text")
+	date, _ := cmd.Flags().GetString("filter-date")
+	from, _ := cmd.Flags().GetString("filter-from")
+	to, _ := cmd.Flags().GetString("filter-to")
+	category, _ := cmd.Flags().GetString("filter-category")
+	status, _ := cmd.Flags().GetString("filter-status")
+	tagFlags, _ := cmd.Flags().GetStringArray("filter-tag")
+	anyTagFlags, _ := cmd.Flags().GetStringArray("filter-any-tag")
+
+	tags, err := core.NormalizeTags(tagFlags)
+	if err != nil {
+		return nil, err
+	}
+	anyTags, err := core.NormalizeTags(anyTagFlags)
+	if err != nil {
+		return nil, err
+	}
+
+	hasFilters := text != "" || date != "" || from != "" || to != "" || category != "" || status != "" ||
+		len(tags) > 0 || len(anyTags) > 0
+
+	if len(args) > 0 && hasFilters {
+		return nil, fmt.Errorf("select tasks either by ID or with --filter-* flags, not both")
+	}
+	if len(args) == 0 && !hasFilters {
+		return nil, fmt.Errorf("no tasks selected: pass task IDs, a range such as 12-18, or --filter-* flags")
+	}
+
+	if len(args) > 0 {
+		ids, explicit, err := parseIDArgs(args)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks, err := taskManager.GetTasksByIDs(ids)
+		if err != nil {
+			return nil, err
+		}
+
+		// Los huecos dentro de un rango se ignoran; un ID indicado explícitamente debe existir
+		found := make(map[int]bool, len(tasks))
+		for _, task := range tasks {
+			found[task.ID] = true
+		}
+		for _, id := range ids {
+			if explicit[id] && !found[id] {
+				return nil, fmt.Errorf("task with ID %d not found", id)
+			}
+		}
+
+		if len(tasks) == 0 {
+			return nil, fmt.Errorf("no tasks found in the given ID range")
+		}
+		return tasks, nil
+	}
+
+	if date != "" && (from != "" || to != "") {
+		return nil, fmt.Errorf("--filter-date cannot be combined with --filter-from or --filter-to")
+	}
+
+	// Una semana ISO en --filter-from empieza el lunes; en --filter-to termina el domingo
+	dateRange, err := parseDateRangeFlag(date)
+	if err != nil {
+		return nil, err
+	}
+	if from != "" {
+		fromRange, err := parseDateRangeFlag(from)
+		if err != nil {
+			return nil, err
+		}
+		dateRange.From = fromRange.From
+	}
+	if to != "" {
+		toRange, err := parseDateRangeFlag(to)
+		if err != nil {
+			return nil, err
+		}
+		dateRange.To = toRange.To
+	}
+
+	filter := core.TaskFilter{
+		From:     dateRange.From,
+		To:       dateRange.To,
+		Category: category,
+		Status:   status,
+		Query:    text,
+		Tags:     tags,
+		AnyTags:  anyTags,
+	}
+	tasks, err := searchWorkedTasks(taskManager, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no tasks match the given filters")
+	}
+	return tasks, nil
+}
+
+// searchWorkedTasks devuelve una vez cada tarea trabajada en el rango del filtro, completa y ordenada por ID.
+// Una tarea entra si tiene horas registradas en el rango, o si no tiene horas y su fecha cae en él.
+func searchWorkedTasks(taskManager core.TaskStore, filter core.TaskFilter) ([]workflow.Task, error) {
+	worked, err := taskManager.SearchTasksInRange(filter)
+	if err != nil {
+		return nil, err
+	}
+	return taskManager.GetTasksByIDs(workedTaskIDs(worked))
+}
+
+// workedTaskIDs devuelve los IDs distintos de una lista de días trabajados, en orden ascendente
+func workedTaskIDs(worked []workflow.Task) []int {
+	seen := make(map[int]bool, len(worked))
+	var ids []int
+	for _, task := range worked {
+		if !seen[task.ID] {
+			seen[task.ID] = true
+			ids = append(ids, task.ID)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// maxSelectedIDs limita cuántos IDs pueden seleccionar los rangos de una sola vez
+const maxSelectedIDs = 1000
+
+// parseIDArgs convierte argumentos como "3", "12-18" o "4,7" en una lista de IDs sin duplicados.
+// También devuelve qué IDs se indicaron explícitamente y no como parte de un rango.
+func parseIDArgs(args []string) ([]int, map[int]bool, error) {
+	seen := make(map[int]bool)
+	explicit := make(map[int]bool)
+	var ids []int
+
+	add := func(id int) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	for _, arg := range args {
+		for _, part := range strings.Split(arg, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+
+			if start, end, isRange := strings.Cut(part, "-"); isRange {
+				first, err1 := strconv.Atoi(start)
+				last, err2 := strconv.Atoi(end)
+				if err1 != nil || err2 != nil || first <= 0 || last < first {
+					return nil, nil, fmt.Errorf("invalid task ID range: %s", part)
+				}
+				if last-first >= maxSelectedIDs-len(ids) {
+					return nil, nil, fmt.Errorf("task ID range %s selects more than %d tasks", part, maxSelectedIDs)
+				}
+				for id := first; id <= last; id++ {
+					add(id)
+				}
+				continue
+			}
+
+			id, err := strconv.Atoi(part)
+			if err != nil || id <= 0 {
+				return nil, nil, fmt.Errorf("invalid task ID: %s", part)
+			}
+			add(id)
+			explicit[id] = true
+		}
+	}
+
+	return ids, explicit, nil
+}
+
+// previewTasks muestra las tareas seleccionadas antes de aplicar un cambio
+func previewTasks(title string, tasks []workflow.Task) {
+	fmt.Printf("%s\n", title)
+	for _, task := range tasks {
+		fmt.Printf("  [%d] %s %s (%.1fh, %s, %s) %s%s\n", task.ID, workflow.GetIcon(task.Category), task.Description,
+			task.Hours, task.Category, task.Date, workflow.GetStatusIcon(task.Status), formatTags(task.Tags))
+	}
+	fmt.Println()
+}
+
+// confirm pide una confirmación y/N al usuario, salvo que se use --force
+func confirm(cmd *cobra.Command, question string) bool {
+	if force, _ := cmd.Flags().GetBool("force"); force {
+		return true
+	}
+
+	fmt.Printf("%s (y/N): ", question)
+	var response string
+	fmt.Scanln(&response)
+
+	response = strings.ToLower(strings.TrimSpace(response))
+	return response == "y" || response == "yes"
+}
+
+// pluralTasks describe una cantidad de tareas ("task 3" o "5 tasks")
+func pluralTasks(tasks []workflow.Task) string {
+	if len(tasks) == 1 {
+		return fmt.Sprintf("task %d", tasks[0].ID)
+	}
+	return fmt.Sprintf("%d tasks", len(tasks))
+}
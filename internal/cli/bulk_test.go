@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestParseIDArgs comprueba las listas, los rangos y los IDs indicados explícitamente
+func TestParseIDArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		ids      []int
+		explicit []int
+		wantErr  bool
+	}{
+		{name: "single id", args: []string{"3"}, ids: []int{3}, explicit: []int{3}},
+		{name: "several args", args: []string{"4", "7"}, ids: []int{4, 7}, explicit: []int{4, 7}},
+		{name: "comma list", args: []string{"4,7, 9"}, ids: []int{4, 7, 9}, explicit: []int{4, 7, 9}},
+		{name: "range", args: []string{"12-15"}, ids: []int{12, 13, 14, 15}},
+		{name: "single id range", args: []string{"5-5"}, ids: []int{5}},
+		{name: "range and ids keep order without duplicates", args: []string{"3-5,4", "1"}, ids: []int{3, 4, 5, 1}, explicit: []int{4, 1}},
+		{name: "empty parts are ignored", args: []string{"2,,3,"}, ids: []int{2, 3}, explicit: []int{2, 3}},
+		{name: "not a number", args: []string{"abc"}, wantErr: true},
+		{name: "zero", args: []string{"0"}, wantErr: true},
+		{name: "negative", args: []string{"-3"}, wantErr: true},
+		{name: "reversed range", args: []string{"9-4"}, wantErr: true},
+		{name: "open range", args: []string{"4-"}, wantErr: true},
+		{name: "largest range", args: []string{"1-1000"}, ids: idRange(1, 1000)},
+		{name: "range too large", args: []string{"1-1001"}, wantErr: true},
+		{name: "huge range", args: []string{"1-999999999999"}, wantErr: true},
+		{name: "ranges together too large", args: []string{"1-600", "1001-1500"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, explicit, err := parseIDArgs(tt.args)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseIDArgs(%q) = %v, want error", tt.args, ids)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseIDArgs(%q) error: %v", tt.args, err)
+			}
+			if !reflect.DeepEqual(ids, tt.ids) {
+				t.Errorf("ids = %v, want %v", ids, tt.ids)
+			}
+
+			wantExplicit := make(map[int]bool)
+			for _, id := range tt.explicit {
+				wantExplicit[id] = true
+			}
+			if !reflect.DeepEqual(explicit, wantExplicit) {
+				t.Errorf("explicit = %v, want %v", explicit, wantExplicit)
+			}
+		})
+	}
+}
+
+// idRange devuelve los IDs de first a last, inclusive
+func idRange(first int, last int) []int {
+	var ids []int
+	for id := first; id <= last; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,332 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// calendarCmd agrupa los comandos del calendario laboral
+var calendarCmd = &cobra.Command{
+	Use:   "calendar",
+	Short: "Manage the work calendar",
+	Long: `The work calendar defines the first day of the week, the working days, the
+expected hours for each weekday and the public holidays. 'status' and the weekly
+and monthly reports compare worked hours against it.
+
+Examples:
+  workflow calendar show
+  workflow calendar set --week-start sunday --working-days mon-thu --hours mon=9 --hours thu=7
+  workflow calendar holidays import holidays-2025.ics
+  workflow calendar holidays list --year 2025
+`,
+}
+
+// calendarShowCmd muestra el calendario laboral
+var calendarShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show the work calendar and expected hours",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		configManager := core.LoadConfigManager()
+		calendar, err := configManager.GetCalendar()
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		fmt.Println("🗓️  Work calendar:")
+		fmt.Printf("  Week starts on: %s\n", calendar.WeekStart())
+
+		weekday := calendar.WeekStart()
+		for i := 0; i < 7; i++ {
+			if calendar.IsWorkingDay(weekday) {
+				fmt.Printf("  %-10s %.2fh\n", weekday, calendar.HoursFor(weekday))
+			} else {
+				fmt.Printf("  %-10s off\n", weekday)
+			}
+			weekday = (weekday + 1) % 7
+		}
+
+		week, _ := calendar.ExpectedHoursInRange(getWeekStart(), getWeekEnd())
+		month, _ := calendar.ExpectedHoursInRange(getMonthStart(), getMonthEnd())
+		fmt.Printf("\n📈 Expected this week: %.2fh\n", week)
+		fmt.Printf("📈 Expected this month: %.2fh\n", month)
+
+		today := core.Today()
+		var upcoming []workflow.Holiday
+		for _, holiday := range configManager.Get().Calendar.Holidays {
+			if holiday.Date >= today {
+				upcoming = append(upcoming, holiday)
+			}
+		}
+		if len(upcoming) > 0 {
+			fmt.Println("\n🎉 Upcoming holidays:")
+			for _, holiday := range upcoming[:min(len(upcoming), 5)] {
+				fmt.Printf("  %s %s\n", holiday.Date, holiday.Name)
+			}
+		}
+	},
+}
+
+// calendarSetCmd cambia la semana laboral
+var calendarSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Change the week start, working days or hours per weekday",
+	Long: `Change the work week. Weekdays are English names or abbreviations (mon, tuesday).
+Weekdays without their own --hours value expect daily_hours_target.
+
+Examples:
+  workflow calendar set --week-start sunday
+  workflow calendar set --working-days mon,tue,wed,thu
+  workflow calendar set --working-days mon-fri --hours fri=6
+  workflow calendar set --hours fri=default
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		configManager := core.LoadConfigManager()
+		calendar := configManager.Get().Calendar
+
+		if cmd.Flags().Changed("week-start") {
+			weekStart, _ := cmd.Flags().GetString("week-start")
+			weekday, err := core.ParseWeekday(weekStart)
+			if err != nil {
+				printError(err)
+				return
+			}
+			calendar.WeekStart = strings.ToLower(weekday.String())
+		}
+
+		if cmd.Flags().Changed("working-days") {
+			workingDays, _ := cmd.Flags().GetString("working-days")
+			weekdays, err := parseWeekdayList(workingDays)
+			if err != nil {
+				printError(err)
+				return
+			}
+			calendar.WorkingDays = nil
+			for _, weekday := range weekdays {
+				calendar.WorkingDays = append(calendar.WorkingDays, strings.ToLower(weekday.String()))
+			}
+		}
+
+		hoursFlags, _ := cmd.Flags().GetStringArray("hours")
+		if len(hoursFlags) > 0 {
+			dailyHours := make(map[string]float64, len(calendar.DailyHours))
+			for name, hours := range calendar.DailyHours {
+				dailyHours[name] = hours
+			}
+
+			for _, value := range hoursFlags {
+				name, hoursStr, found := strings.Cut(value, "=")
+				weekday, err := core.ParseWeekday(name)
+				if !found || err != nil {
+					printError(fmt.Errorf("invalid --hours value: %s (use e.g. fri=6 or fri=default)", value))
+					return
+				}
+
+				// Las claves se guardan con el nombre completo del día
+				for existing := range dailyHours {
+					if other, _ := core.ParseWeekday(existing); other == weekday {
+						delete(dailyHours, existing)
+					}
+				}
+				if hoursStr == "default" {
+					continue
+				}
+
+				hours, err := strconv.ParseFloat(hoursStr, 64)
+				if err != nil {
+					printError(fmt.Errorf("invalid --hours value: %s (use e.g. fri=6 or fri=default)", value))
+					return
+				}
+				dailyHours[strings.ToLower(weekday.String())] = hours
+			}
+			calendar.DailyHours = dailyHours
+		}
+
+		if err := configManager.SetCalendar(calendar); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess("Work calendar updated")
+	},
+}
+
+// calendarHolidaysCmd agrupa los comandos de feriados
+var calendarHolidaysCmd = &cobra.Command{
+	Use:   "holidays",
+	Short: "Import or list public holidays",
+}
+
+// calendarHolidaysImportCmd importa feriados desde un archivo iCalendar
+var calendarHolidaysImportCmd = &cobra.Command{
+	Use:   "import <file.ics>",
+	Short: "Import public holidays from a local iCalendar file",
+	Long: `Import public holidays from a local iCalendar (.ics) file. Each event becomes a
+holiday on its date; multi-day all-day events add one holiday per day. Holidays
+already in the calendar are kept unless --replace is given.
+
+Examples:
+  workflow calendar holidays import holidays-2025.ics
+  workflow calendar holidays import holidays-2026.ics --replace
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		replace, _ := cmd.Flags().GetBool("replace")
+
+		file, err := os.Open(args[0])
+		if err != nil {
+			printError(fmt.Errorf("could not open calendar file: %v", err))
+			return
+		}
+		defer file.Close()
+
+		events, err := core.ParseICal(file)
+		if err != nil {
+			printError(err)
+			return
+		}
+		if len(events) == 0 {
+			printInfo("No events found in the calendar file")
+			return
+		}
+
+		configManager := core.LoadConfigManager()
+		imported, err := configManager.ImportHolidays(core.HolidaysFromEvents(events), replace)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Imported %d holiday(s) from %s", imported, args[0]))
+	},
+}
+
+// calendarHolidaysListCmd lista los feriados del calendario
+var calendarHolidaysListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List public holidays",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		year, _ := cmd.Flags().GetInt("year")
+
+		configManager := core.LoadConfigManager()
+		holidays := configManager.Get().Calendar.Holidays
+
+		fmt.Println("🎉 Holidays:")
+		shown := 0
+		for _, holiday := range holidays {
+			if year != 0 && !strings.HasPrefix(holiday.Date, strconv.Itoa(year)+"-") {
+				continue
+			}
+			weekday := ""
+			if t, err := time.Parse("2006-01-02", holiday.Date); err == nil {
+				weekday = t.Format("Mon")
+			}
+			fmt.Printf("  %s %s %s\n", holiday.Date, weekday, holiday.Name)
+			shown++
+		}
+
+		if shown == 0 {
+			fmt.Println("  No holidays found.")
+		}
+	},
+}
+
+// parseWeekdayList interpreta una lista como "mon,tue,thu" o un rango como "mon-fri"
+func parseWeekdayList(value string) ([]time.Weekday, error) {
+	var weekdays []time.Weekday
+	seen := make(map[time.Weekday]bool)
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		first, last, isRange := strings.Cut(part, "-")
+		start, err := core.ParseWeekday(first)
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if isRange {
+			if end, err = core.ParseWeekday(last); err != nil {
+				return nil, err
+			}
+		}
+
+		for weekday := start; ; weekday = (weekday + 1) % 7 {
+			if !seen[weekday] {
+				seen[weekday] = true
+				weekdays = append(weekdays, weekday)
+			}
+			if weekday == end {
+				break
+			}
+		}
+	}
+
+	if len(weekdays) == 0 {
+		return nil, fmt.Errorf("at least one working day is required")
+	}
+	return weekdays, nil
+}
+
+// workCalendar devuelve el calendario laboral configurado.
+// Si la configuración no es válida avisa y usa una semana de lunes a viernes.
+func workCalendar() *core.Calendar {
+	configManager := core.LoadConfigManager()
+	calendar, err := configManager.GetCalendar()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: %v, using a Monday to Friday week\n", err)
+		calendar, _ = core.NewCalendar(workflow.DefaultWorkCalendar(), configManager.GetDailyHoursTarget())
+	}
+	return calendar
+}
+
+// expectedHours devuelve las horas esperadas entre dos fechas según el calendario laboral y las ausencias
+func expectedHours(from string, to string) float64 {
+	hours, err := calendarWithLeave(from, to).ExpectedHoursInRange(from, to)
+	if err != nil {
+		printError(err)
+		return 0
+	}
+	return hours
+}
+
+// reportExpected compara las horas trabajadas de un período con las esperadas según el calendario
+// laboral y las ausencias, junto con el saldo acumulado hasta el final del período
+func reportExpected(from string, to string, workedHours float64) *core.ReportExpected {
+	scheduled, err := workCalendar().ExpectedHoursInRange(from, to)
+	if err != nil {
+		printError(err)
+		return nil
+	}
+
+	expected := &core.ReportExpected{Scheduled: scheduled, Expected: expectedHours(from, to), Worked: workedHours}
+	expected.BalanceStart, expected.Balance = runningBalance(to)
+	return expected
+}
+
+func init() {
+	calendarSetCmd.Flags().String("week-start", "", "First day of the week (e.g. monday, sunday)")
+	calendarSetCmd.Flags().String("working-days", "", "Working days, as a list or range (e.g. mon-fri, mon,tue,thu)")
+	calendarSetCmd.Flags().StringArray("hours", nil, "Expected hours for a weekday, e.g. fri=6 or fri=default (repeatable)")
+	calendarHolidaysImportCmd.Flags().Bool("replace", false, "Remove existing holidays before importing")
+	calendarHolidaysListCmd.Flags().Int("year", 0, "Only list holidays of this year")
+
+	calendarHolidaysCmd.AddCommand(calendarHolidaysImportCmd)
+	calendarHolidaysCmd.AddCommand(calendarHolidaysListCmd)
+	calendarCmd.AddCommand(calendarShowCmd)
+	calendarCmd.AddCommand(calendarSetCmd)
+	calendarCmd.AddCommand(calendarHolidaysCmd)
+}
@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// categoryCmd agrupa los comandos de gestión de categorías
+var categoryCmd = &cobra.Command{
+	Use:   "category",
+	Short: "Manage task categories",
+	Long: `Manage the task categories defined in ~/.workflow/config.json.
+
+Examples:
+  workflow category list
+  workflow category add oncall --icon 📟 --color red --default-hours 0.5
+  workflow category rename doc docs
+  workflow category remove oncall
+`,
+}
+
+// categoryAddCmd agrega una categoría a la configuración
+var categoryAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a new category",
+	Long: `Add a new category. Colors can be a name (red, blue, ...) or #rrggbb.
+
+Examples:
+  workflow category add oncall --icon 📟 --color red
+  workflow category add training --color "#ff8800" --no-billable
+  workflow category add standup --default-hours 0.25
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		icon, _ := cmd.Flags().GetString("icon")
+		color, _ := cmd.Flags().GetString("color")
+		noBillable, _ := cmd.Flags().GetBool("no-billable")
+		defaultHours, _ := cmd.Flags().GetFloat64("default-hours")
+
+		configManager := core.LoadConfigManager()
+		category := workflow.Category{
+			Name:         args[0],
+			Icon:         icon,
+			Color:        color,
+			Billable:     !noBillable,
+			DefaultHours: defaultHours,
+		}
+		if err := configManager.AddCategory(category); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Added category: %s %s", workflow.GetIcon(args[0]), args[0]))
+	},
+}
+
+// categoryListCmd lista las categorías configuradas
+var categoryListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List categories",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		configManager := core.LoadConfigManager()
+		categories := configManager.GetCategories()
+
+		fmt.Println("🏷️  Categories:")
+		if len(categories) == 0 {
+			fmt.Println("  No categories configured.")
+			return
+		}
+
+		for _, category := range categories {
+			details := category.Color
+			if details == "" {
+				details = "no color"
+			}
+			if category.Billable {
+				details += ", billable"
+			} else {
+				details += ", non-billable"
+			}
+			if category.DefaultHours > 0 {
+				details += fmt.Sprintf(", default %.2fh", category.DefaultHours)
+			}
+			fmt.Printf("  %s %-12s (%s)\n", workflow.GetIcon(category.Name), category.Name, details)
+		}
+	},
+}
+
+// categoryRemoveCmd quita una categoría de la configuración
+var categoryRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove a category",
+	Long: `Remove a category from the configuration.
+Categories still used by tasks are only removed with --force; those tasks keep their category name.
+The default category (general) is used by tasks added without a category and cannot be removed.
+
+Examples:
+  workflow category remove oncall
+  workflow category remove oncall --force
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		force, _ := cmd.Flags().GetBool("force")
+
+		taskManager := core.NewTaskStore()
+		defer taskManager.Close()
+
+		count, err := taskManager.CountTasksInCategory(args[0])
+		if err != nil {
+			printError(err)
+			return
+		}
+		if count > 0 && !force {
+			printError(fmt.Errorf("category '%s' is used by %d task(s); rename it or use --force", args[0], count))
+			return
+		}
+
+		if err := core.LoadConfigManager().RemoveCategory(args[0]); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Category %s removed", args[0]))
+	},
+}
+
+// categoryRenameCmd renombra una categoría y reescribe las tareas existentes
+var categoryRenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "Rename a category and its tasks",
+	Long: `Rename a category. Existing tasks, recurring tasks, shortcuts and the category rate are moved
+to the new name.
+Each moved task is recorded in the history, so 'workflow undo' can revert it
+(rename the category back as well to keep it configured).
+The default category (general) cannot be renamed.
+
+Examples:
+  workflow category rename doc docs
+`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		taskManager := core.NewTaskStore()
+		defer taskManager.Close()
+
+		renamed, err := taskManager.RenameCategory(args[0], args[1])
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Category %s renamed to %s (%d task(s) updated)", args[0], args[1], renamed))
+	},
+}
+
+func init() {
+	categoryAddCmd.Flags().String("icon", "", "Icon shown next to tasks in this category")
+	categoryAddCmd.Flags().String("color", "", "Color name or #rrggbb")
+	categoryAddCmd.Flags().Bool("no-billable", false, "Mark the category as non-billable")
+	categoryAddCmd.Flags().Float64("default-hours", 0, "Default hours for quick-add shortcuts")
+	categoryRemoveCmd.Flags().Bool("force", false, "Remove the category even if tasks use it")
+
+	categoryCmd.AddCommand(categoryAddCmd)
+	categoryCmd.AddCommand(categoryListCmd)
+	categoryCmd.AddCommand(categoryRemoveCmd)
+	categoryCmd.AddCommand(categoryRenameCmd)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -24,6 +25,7 @@ A simple and efficient tool for tracking your daily tasks and generating reports
 
 // Execute ejecuta el comando raíz
 func Execute() error {
+	registerShortcutCommands()
 	return rootCmd.Execute()
 }
 
@@ -37,24 +39,44 @@ Usage:
 
 Available Commands:
   add         Add a new task with description and hours
-  tech        Add a technical development task
-  meeting     Add a meeting or collaboration task
-  qa          Add a QA/testing task
-  daily       Add daily standup meeting
+{{shortcutHelp}}  start       Start a timer for a task
+  stop        Stop the running timer and record its hours
+  pause       Pause the running timer
+  resume      Resume the paused timer
+  timer       Manage the running timer (cancel)
+  log         Log hours on an existing task for a given day
   status      Show today's task status and progress
+  project     Manage projects and clients
+  category    Manage task categories
   report      Generate detailed report for workflow
   list        List tasks with filters (date, category, status)
   search      Search tasks by text, category, or status
-  edit        Edit existing task (description, hours, category)
-  delete      Delete a task with confirmation
-  complete    Mark task as completed
-  duplicate   Duplicate a task with date options
-  export      Export tasks to CSV/JSON format
+  edit        Edit tasks by ID, range or filters
+  delete      Move tasks to the trash with confirmation
+  restore     Restore a task from the trash
+  trash       List or empty the trash
+  complete    Mark tasks as completed
+  history     Show the change history of a task
+  undo        Undo the last task changes
+  duplicate   Duplicate tasks with date options
+  recurring   Manage recurring task templates
+  calendar    Manage the work calendar and holidays
+  leave       Track vacation, sick days and personal holidays
+  balance     Show the flexitime balance (worked minus expected hours)
+  rate        Manage hourly rates for invoices
+  invoice     Price billable hours for a client and period
+  timesheet   Show a week as a grid of hours per group and day
+  export      Export tasks to CSV, JSON, ICS and other formats
+  import      Import tasks from CSV/JSON/ICS or other time trackers
   migrate     Migrate from JSON to SQLite database
+  db          Database maintenance (schema migrations)
   upgrade     Upgrade to latest version
   check-update Check for available updates
   version     Show version information
 
+Global Flags:
+  --tz <zone>  Timezone for today, week and month boundaries (e.g. Europe/Madrid)
+
 Enterprise Features:
   • SQLite Database: Fast and scalable task storage
   • Auto-Update: Automatic version management
@@ -81,19 +103,18 @@ Use "workflow [command] --help" for more information about a command.
 	rootCmd.AddCommand(versionCmd)
 
 	// Comando add
-	addCmd.Flags().String("date", "", "Specific date for the task (format: YYYY-MM-DD)")
+	addCmd.Flags().String("date", "", "Specific date for the task ("+dateFlagHelp+")")
 	addCmd.Flags().Bool("yesterday", false, "Add task for yesterday")
 	addCmd.Flags().Bool("tomorrow", false, "Add task for tomorrow")
+	addCmd.Flags().String("project", "", "Project the task belongs to")
+	addCmd.Flags().StringArray("tag", nil, "Tag for the task (repeatable)")
+	addBillableFlags(addCmd)
 	rootCmd.AddCommand(addCmd)
 
 	// Comando status
 	rootCmd.AddCommand(statusCmd)
 
-	// Comandos específicos
-	rootCmd.AddCommand(techCmd)
-	rootCmd.AddCommand(meetingCmd)
-	rootCmd.AddCommand(qaCmd)
-	rootCmd.AddCommand(dailyCmd)
+	// Los comandos rápidos (tech, meeting, qa, daily...) se registran desde la configuración en Execute
 
 	// Comando report
 	rootCmd.AddCommand(reportCmd)
@@ -105,16 +126,25 @@ Use "workflow [command] --help" for more information about a command.
 	rootCmd.AddCommand(rollbackCmd)
 
 	// Comando list
-	listCmd.Flags().String("date", "", "Date to list tasks for (format: YYYY-MM-DD)")
+	listCmd.Flags().String("date", "", "Date or week to list tasks for ("+dateRangeFlagHelp+")")
 	rootCmd.AddCommand(listCmd)
 
 	// Flags para edit
 	editCmd.Flags().String("description", "", "New description for the task")
 	editCmd.Flags().String("hours", "", "New hours for the task")
 	editCmd.Flags().String("category", "", "New category for the task")
+	editCmd.Flags().String("status", "", "New status for the task (pending, in_progress, completed, paused)")
+	editCmd.Flags().String("project", "", "New project for the task (empty to clear)")
+	editCmd.Flags().StringArray("tag", nil, "Add a tag to the task (repeatable)")
+	editCmd.Flags().StringArray("untag", nil, "Remove a tag from the task (repeatable)")
+	addBillableFlags(editCmd)
+
+	editCmd.Flags().Bool("force", false, "Edit several tasks without confirmation")
+	addSelectionFlags(editCmd)
 
 	// Flags para delete
 	deleteCmd.Flags().Bool("force", false, "Force deletion without confirmation")
+	addSelectionFlags(deleteCmd)
 
 	// Agregar comandos
 	rootCmd.AddCommand(editCmd)
@@ -122,6 +152,7 @@ Use "workflow [command] --help" for more information about a command.
 
 	// Flags para complete
 	completeCmd.Flags().Bool("force", false, "Force completion without confirmation")
+	addSelectionFlags(completeCmd)
 
 	// Agregar comando
 	rootCmd.AddCommand(completeCmd)
@@ -129,21 +160,68 @@ Use "workflow [command] --help" for more information about a command.
 	// Flags para search
 	searchCmd.Flags().String("category", "", "Filter by category")
 	searchCmd.Flags().String("status", "", "Filter by status (pending, in_progress, completed, paused)")
-	searchCmd.Flags().String("date", "", "Filter by date (format: YYYY-MM-DD)")
+	searchCmd.Flags().String("date", "", "Filter by worked date or week ("+dateRangeFlagHelp+")")
+	addTagFilterFlags(searchCmd)
 
 	// Flags para report
-	reportCmd.Flags().String("date", "", "Generate report for specific date (format: YYYY-MM-DD)")
+	reportCmd.Flags().String("date", "", "Generate report for specific date or week ("+dateRangeFlagHelp+")")
 	reportCmd.Flags().Bool("week", false, "Generate weekly report")
 	reportCmd.Flags().Bool("month", false, "Generate monthly report")
+	reportCmd.Flags().String("from", "", "Start of custom date range, inclusive ("+dateRangeFlagHelp+")")
+	reportCmd.Flags().String("to", "", "End of custom date range, inclusive ("+dateRangeFlagHelp+")")
 	reportCmd.Flags().String("category", "", "Filter by category")
+	reportCmd.Flags().String("project", "", "Filter by project")
+	reportCmd.Flags().String("client", "", "Filter by client")
+	addTagFilterFlags(reportCmd)
 	reportCmd.Flags().String("status", "", "Filter by status (pending, in_progress, completed, paused)")
 	reportCmd.Flags().Bool("workflow", false, "Generate legacy workflow format report")
+	reportCmd.Flags().String("format", "text", "Report format (text, md, html)")
+	reportCmd.Flags().String("output", "", "Write the report to this file instead of the terminal")
 
 	// Agregar comando
 	rootCmd.AddCommand(searchCmd)
 	rootCmd.AddCommand(migrateCmd)
 	rootCmd.AddCommand(duplicateCmd)
 	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(importCmd)
+	rootCmd.AddCommand(dbCmd)
+
+	// Comandos de temporizador
+	rootCmd.AddCommand(startCmd)
+	rootCmd.AddCommand(stopCmd)
+	rootCmd.AddCommand(pauseCmd)
+	rootCmd.AddCommand(resumeCmd)
+	rootCmd.AddCommand(timerCmd)
+
+	// Comando log
+	rootCmd.AddCommand(logCmd)
+
+	// Comando project
+	rootCmd.AddCommand(projectCmd)
+
+	// Comando category
+	rootCmd.AddCommand(categoryCmd)
+
+	// Comando recurring
+	rootCmd.AddCommand(recurringCmd)
+
+	// Comandos de historial
+	rootCmd.AddCommand(historyCmd)
+	rootCmd.AddCommand(undoCmd)
+
+	// Comandos de papelera
+	rootCmd.AddCommand(trashCmd)
+	rootCmd.AddCommand(restoreCmd)
+
+	// Comando calendar
+	rootCmd.AddCommand(calendarCmd)
+
+	// Comando leave
+	rootCmd.AddCommand(leaveCmd)
+	rootCmd.AddCommand(balanceCmd)
+	rootCmd.AddCommand(rateCmd)
+	rootCmd.AddCommand(invoiceCmd)
+	rootCmd.AddCommand(timesheetCmd)
 }
 
 // rollbackCmd es el comando para gestionar rollbacks
@@ -255,7 +333,12 @@ Examples:
   workflow add "Meeting" 1.0 meeting
   workflow add --date 2025-07-20 "Tarea del lunes" 3.0
   workflow add --yesterday "Tarea olvidada" 2.0
-  workflow add --tomorrow "Planificación" 1.5`,
+  workflow add --tomorrow "Planificación" 1.5
+  workflow add --date "last friday" "Deploy" 1.0
+  workflow add --date -3d "Code review" 2.0
+  workflow add "Landing page" 3.0 tech --project "Website redesign"
+  workflow add "Fix login" 1.5 tech --tag JIRA-123 --tag billable
+  workflow add "Internal sync" 0.5 meeting --no-billable`,
 	Args: cobra.MinimumNArgs(2),
 	Run: func(cmd *cobra.Command, args []string) {
 		description := args[0]
@@ -272,13 +355,12 @@ Examples:
 		}
 
 		// Categoría por defecto
-		category := "general"
+		category := workflow.DefaultCategory
 		if len(args) > 2 {
 			category = args[2]
 		}
 
 		// Determinar fecha basada en flags
-		date := ""
 		dateFlag, _ := cmd.Flags().GetString("date")
 		yesterdayFlag, _ := cmd.Flags().GetBool("yesterday")
 		tomorrowFlag, _ := cmd.Flags().GetBool("tomorrow")
@@ -300,23 +382,65 @@ Examples:
 			return
 		}
 
-		// Establecer fecha según flags
-		if dateFlag != "" {
-			date = dateFlag
-		} else if yesterdayFlag {
-			date = time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+		// Establecer fecha según flags; --yesterday y --tomorrow son atajos de --date
+		if yesterdayFlag {
+			dateFlag = "yesterday"
 		} else if tomorrowFlag {
-			date = time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+			dateFlag = "tomorrow"
+		} else if dateFlag == "" {
+			dateFlag = "today"
+		}
+
+		date, err := parseDateFlag(dateFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		project, _ := cmd.Flags().GetString("project")
+		tagFlags, _ := cmd.Flags().GetStringArray("tag")
+		tags, err := core.NormalizeTags(tagFlags)
+		if err != nil {
+			printError(err)
+			return
+		}
+		billable, _, err := readBillableFlags(cmd)
+		if err != nil {
+			printError(err)
+			return
 		}
 
 		// Agregar tarea
-		taskManager := core.NewTaskManager()
-		if err := taskManager.AddTask(description, hours, category, date); err != nil {
+		taskManager := core.NewTaskStore()
+		defer taskManager.Close()
+
+		// Validar la categoría contra las configuradas
+		if err := taskManager.ValidateCategory(category); err != nil {
 			printError(err)
 			return
 		}
 
-		printSuccess(fmt.Sprintf("Added task: %s (%.1fh %s)", description, hours, category))
+		task := &workflow.Task{
+			Description: description,
+			Hours:       hours,
+			Category:    category,
+			Date:        date,
+			Status:      workflow.StatusPending,
+			CreatedAt:   time.Now().UTC(),
+			Project:     project,
+			Tags:        tags,
+			Billable:    billable,
+		}
+		if err := taskManager.CreateTask(task); err != nil {
+			printError(err)
+			return
+		}
+
+		if project != "" {
+			printSuccess(fmt.Sprintf("Added task: %s (%.1fh %s, project: %s)", description, hours, category, project))
+		} else {
+			printSuccess(fmt.Sprintf("Added task: %s (%.1fh %s)", description, hours, category))
+		}
 
 		// Mostrar estado actual
 		showStatus(taskManager)
@@ -344,7 +468,7 @@ func parseHours(hoursStr string) (float64, error) {
 }
 
 // showStatus muestra el estado actual
-func showStatus(taskManager *core.TaskManager) {
+func showStatus(taskManager core.TaskStore) {
 	todayTasks, err := taskManager.GetTodayTasks()
 	if err != nil {
 		printError(err)
@@ -352,31 +476,7 @@ func showStatus(taskManager *core.TaskManager) {
 	}
 
 	totalHours := taskManager.GetTotalHours(todayTasks)
-	targetHours := taskManager.GetDailyHoursTarget()
-
-	fmt.Printf("\n📊 Today's Status: %.1fh / %.1fh (%.1fh remaining)\n",
-		totalHours, targetHours, targetHours-totalHours)
-
-	if len(todayTasks) > 0 {
-		fmt.Println("\n📝 Today's tasks:")
-		for _, task := range todayTasks {
-			icon := workflow.GetIcon(task.Category)
-			statusIcon := workflow.GetStatusIcon(task.Status)
-			fmt.Printf("  [%d] %s %s - %.1fh (%s) %s\n", task.ID, icon, task.Description, task.Hours, task.Category, statusIcon)
-		}
-	}
-}
-
-// showStatusSQLite muestra el estado actual para SQLite
-func showStatusSQLite(taskManager *core.TaskManagerSQLite) {
-	todayTasks, err := taskManager.GetTodayTasks()
-	if err != nil {
-		printError(err)
-		return
-	}
-
-	totalHours := taskManager.GetTotalHours(todayTasks)
-	targetHours := taskManager.GetDailyHoursTarget()
+	targetHours := expectedHours(core.Today(), core.Today())
 
 	fmt.Printf("\n📊 Today's Status: %.1fh / %.1fh (%.1fh remaining)\n",
 		totalHours, targetHours, targetHours-totalHours)
@@ -401,16 +501,17 @@ Displays:
 - Current date
 - Hours worked vs target
 - Remaining hours
-- List of today's tasks with icons`,
+- List of today's tasks with icons
+- Running timer, if any`,
 	Run: func(cmd *cobra.Command, args []string) {
-		taskManager := core.NewTaskManagerSQLite()
+		taskManager := core.NewTaskStore()
 		defer taskManager.Close()
-		showDetailedStatusSQLite(taskManager)
+		showDetailedStatus(taskManager)
 	},
 }
 
 // showDetailedStatus muestra el estado detallado
-func showDetailedStatus(taskManager *core.TaskManager) {
+func showDetailedStatus(taskManager core.TaskStore) {
 	todayTasks, err := taskManager.GetTodayTasks()
 	if err != nil {
 		printError(err)
@@ -418,75 +519,34 @@ func showDetailedStatus(taskManager *core.TaskManager) {
 	}
 
 	totalHours := taskManager.GetTotalHours(todayTasks)
-	targetHours := taskManager.GetDailyHoursTarget()
-	remainingHours := targetHours - totalHours
-
-	// Mostrar fecha
-	fmt.Printf("📅 Today (%s): %.2fh / %.1fh\n",
-		time.Now().Format("2006-01-02"), totalHours, targetHours)
-
-	// Mostrar horas restantes
-	if remainingHours > 0 {
-		fmt.Printf("📈 Remaining: %.2fh\n", remainingHours)
-	} else if remainingHours < 0 {
-		fmt.Printf("📈 Overtime: %.2fh\n", -remainingHours)
-	} else {
-		fmt.Printf("📈 Perfect! Target reached\n")
-	}
-
-	// Mostrar tareas
-	if len(todayTasks) > 0 {
-		for _, task := range todayTasks {
-			icon := workflow.GetIcon(task.Category)
-			statusIcon := workflow.GetStatusIcon(task.Status)
-			fmt.Printf("  [%d] %s %s (%.1fh, %s) %s\n", task.ID, icon, task.Description, task.Hours, task.Category, statusIcon)
-		}
-	} else {
-		fmt.Println("  No tasks for today")
-	}
-
-	// Mostrar barra de progreso
-	percentage := (totalHours / targetHours) * 100
-	if percentage > 100 {
-		percentage = 100
-	}
-
-	barLength := 20
-	filledLength := int((percentage / 100) * float64(barLength))
-
-	fmt.Printf("📊 [")
-	for i := 0; i < barLength; i++ {
-		if i < filledLength {
-			fmt.Print("█")
-		} else {
-			fmt.Print("░")
-		}
-	}
-	fmt.Printf("] %.1f%%\n", percentage)
-}
-
-// showDetailedStatusSQLite muestra el estado detallado para SQLite
-func showDetailedStatusSQLite(taskManager *core.TaskManagerSQLite) {
-	todayTasks, err := taskManager.GetTodayTasks()
+	calendar := calendarWithLeave(core.Today(), core.Today())
+	targetHours, err := calendar.ExpectedHours(core.Today())
 	if err != nil {
 		printError(err)
 		return
 	}
-
-	totalHours := taskManager.GetTotalHours(todayTasks)
-	targetHours := taskManager.GetDailyHoursTarget()
 	remainingHours := targetHours - totalHours
 
 	// Mostrar fecha
 	fmt.Printf("📅 Today (%s): %.2fh / %.1fh\n",
-		time.Now().Format("2006-01-02"), totalHours, targetHours)
+		core.Today(), totalHours, targetHours)
+
+	// Avisar si hoy no se esperan horas según el calendario laboral
+	leaveHours, _ := calendar.LeaveHours(core.Today())
+	if holiday, isHoliday := calendar.Holiday(core.Today()); isHoliday {
+		fmt.Printf("🎉 Holiday: %s\n", holiday)
+	} else if leaveHours > 0 {
+		fmt.Printf("🌴 On leave: %.2fh\n", leaveHours)
+	} else if targetHours == 0 {
+		fmt.Println("🌴 Non-working day")
+	}
 
 	// Mostrar horas restantes
 	if remainingHours > 0 {
 		fmt.Printf("📈 Remaining: %.2fh\n", remainingHours)
 	} else if remainingHours < 0 {
 		fmt.Printf("📈 Overtime: %.2fh\n", -remainingHours)
-	} else {
+	} else if targetHours > 0 {
 		fmt.Printf("📈 Perfect! Target reached\n")
 	}
 
@@ -502,7 +562,10 @@ func showDetailedStatusSQLite(taskManager *core.TaskManagerSQLite) {
 	}
 
 	// Mostrar barra de progreso
-	percentage := (totalHours / targetHours) * 100
+	percentage := 100.0
+	if targetHours > 0 {
+		percentage = (totalHours / targetHours) * 100
+	}
 	if percentage > 100 {
 		percentage = 100
 	}
@@ -519,134 +582,9 @@ func showDetailedStatusSQLite(taskManager *core.TaskManagerSQLite) {
 		}
 	}
 	fmt.Printf("] %.1f%%\n", percentage)
-}
-
-// techCmd es el comando para agregar tareas técnicas
-var techCmd = &cobra.Command{
-	Use:   "tech [description] [hours]",
-	Short: "Add a technical task",
-	Long: `Add a technical task (development, coding, etc.).
-
-Examples:
-  workflow tech "Fix bug" 2.0
-  workflow tech "Development" 3.5`,
-	Args: cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		description := args[0]
-		hoursStr := args[1]
 
-		// Validar horas
-		hours, err := parseHours(hoursStr)
-		if err != nil {
-			printError(fmt.Errorf("invalid hours: %s", hoursStr))
-			return
-		}
-
-		// Agregar tarea técnica
-		taskManager := core.NewTaskManagerSQLite()
-		defer taskManager.Close()
-		if err := taskManager.AddTask(description, hours, "tech", ""); err != nil {
-			printError(err)
-			return
-		}
-
-		printSuccess(fmt.Sprintf("Added tech task: %s (%.1fh)", description, hours))
-		showStatusSQLite(taskManager)
-	},
-}
-
-// meetingCmd es el comando para agregar reuniones
-var meetingCmd = &cobra.Command{
-	Use:   "meeting [description] [hours]",
-	Short: "Add a meeting task",
-	Long: `Add a meeting task (team sync, planning, etc.).
-
-Examples:
-  workflow meeting "Team sync" 1.0
-  workflow meeting "Planning" 2.0`,
-	Args: cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		description := args[0]
-		hoursStr := args[1]
-
-		// Validar horas
-		hours, err := parseHours(hoursStr)
-		if err != nil {
-			printError(fmt.Errorf("invalid hours: %s", hoursStr))
-			return
-		}
-
-		// Agregar tarea de reunión
-		taskManager := core.NewTaskManagerSQLite()
-		defer taskManager.Close()
-		if err := taskManager.AddTask(description, hours, "meeting", ""); err != nil {
-			printError(err)
-			return
-		}
-
-		printSuccess(fmt.Sprintf("Added meeting: %s (%.1fh)", description, hours))
-		showStatusSQLite(taskManager)
-	},
-}
-
-// qaCmd es el comando para agregar tareas de QA
-var qaCmd = &cobra.Command{
-	Use:   "qa [description] [hours]",
-	Short: "Add a QA/testing task",
-	Long: `Add a QA/testing task (testing, quality assurance, etc.).
-
-Examples:
-  workflow qa "Testing" 1.5
-  workflow qa "Bug fixes" 2.0`,
-	Args: cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		description := args[0]
-		hoursStr := args[1]
-
-		// Validar horas
-		hours, err := parseHours(hoursStr)
-		if err != nil {
-			printError(fmt.Errorf("invalid hours: %s", hoursStr))
-			return
-		}
-
-		// Agregar tarea de QA
-		taskManager := core.NewTaskManagerSQLite()
-		defer taskManager.Close()
-		if err := taskManager.AddTask(description, hours, "qa", ""); err != nil {
-			printError(err)
-			return
-		}
-
-		printSuccess(fmt.Sprintf("Added QA task: %s (%.1fh)", description, hours))
-		showStatusSQLite(taskManager)
-	},
-}
-
-// dailyCmd es el comando para agregar daily standup
-var dailyCmd = &cobra.Command{
-	Use:   "daily",
-	Short: "Add daily standup",
-	Long: `Add daily standup task (automatically 0.25h).
-
-This command automatically adds a daily standup task with the default
-duration configured in your settings.`,
-	Args: cobra.NoArgs,
-	Run: func(cmd *cobra.Command, args []string) {
-		// Obtener duración del daily desde configuración
-		taskManager := core.NewTaskManagerSQLite()
-		defer taskManager.Close()
-		dailyHours := taskManager.GetDailyStandupHours()
-
-		// Agregar tarea de daily
-		if err := taskManager.AddTask("Daily Standup", dailyHours, "daily", ""); err != nil {
-			printError(err)
-			return
-		}
-
-		printSuccess(fmt.Sprintf("Added daily standup (%.2fh)", dailyHours))
-		showStatusSQLite(taskManager)
-	},
+	// Mostrar temporizador en curso
+	showActiveTimer(taskManager)
 }
 
 // reportCmd es el comando para generar reportes
@@ -660,50 +598,74 @@ You can specify date ranges and filters to customize the report.
 Examples:
   workflow report
   workflow report --date 2025-07-21
+  workflow report --date "last friday"
+  workflow report --date 2025-W30
   workflow report --week
   workflow report --month
+  workflow report --from 2025-01-01 --to 2025-06-30
   workflow report --date 2025-07-21 --category tech
+  workflow report --month --client "Acme Corp"
+  workflow report --week --tag billable
   workflow report --status completed
+  workflow report --week --format md --output week.md
+  workflow report --month --format html --output month.html
   workflow report --workflow (legacy format for workflow app)`,
 	Run: func(cmd *cobra.Command, args []string) {
 		dateFlag, _ := cmd.Flags().GetString("date")
 		weekFlag, _ := cmd.Flags().GetBool("week")
 		monthFlag, _ := cmd.Flags().GetBool("month")
+		fromFlag, _ := cmd.Flags().GetString("from")
+		toFlag, _ := cmd.Flags().GetString("to")
 		categoryFlag, _ := cmd.Flags().GetString("category")
 		statusFlag, _ := cmd.Flags().GetString("status")
+		projectFlag, _ := cmd.Flags().GetString("project")
+		clientFlag, _ := cmd.Flags().GetString("client")
 		workflowFlag, _ := cmd.Flags().GetBool("workflow")
-
-		// Validar que solo se use un flag de período
-		periodFlagsCount := 0
-		if dateFlag != "" {
-			periodFlagsCount++
+		formatFlag, _ := cmd.Flags().GetString("format")
+		outputFlag, _ := cmd.Flags().GetString("output")
+		tags, anyTags, err := readTagFilters(cmd)
+		if err != nil {
+			printError(err)
+			return
 		}
-		if weekFlag {
-			periodFlagsCount++
+
+		if _, exists := reportRenderers[formatFlag]; !exists {
+			printError(fmt.Errorf("unsupported format: %s. Supported formats: %s", formatFlag, strings.Join(reportFormats(), ", ")))
+			return
 		}
-		if monthFlag {
-			periodFlagsCount++
+		if workflowFlag && (cmd.Flags().Changed("format") || outputFlag != "") {
+			printError(fmt.Errorf("--workflow cannot be combined with --format or --output"))
+			return
 		}
 
-		if periodFlagsCount > 1 {
-			printError(fmt.Errorf("only one period flag can be used at a time (--date, --week, --month)"))
+		// Determinar período del reporte
+		reportPeriod, err := resolvePeriod(dateFlag, weekFlag, monthFlag, fromFlag, toFlag)
+		if err != nil {
+			printError(err)
 			return
 		}
 
 		if workflowFlag {
 			// Formato legacy para workflow
-			taskManager := core.NewTaskManagerSQLite()
+			taskManager := core.NewTaskStore()
 			defer taskManager.Close()
 			generateworkflowReport(taskManager)
 		} else {
 			// Nuevo formato detallado
-			performDetailedReport(dateFlag, weekFlag, monthFlag, categoryFlag, statusFlag)
+			performDetailedReport(reportPeriod, core.TaskFilter{
+				Category: categoryFlag,
+				Status:   statusFlag,
+				Project:  projectFlag,
+				Client:   clientFlag,
+				Tags:     tags,
+				AnyTags:  anyTags,
+			}, formatFlag, outputFlag)
 		}
 	},
 }
 
 // generateworkflowReport genera el reporte legacy para workflow
-func generateworkflowReport(taskManager *core.TaskManagerSQLite) {
+func generateworkflowReport(taskManager core.TaskStore) {
 	todayTasks, err := taskManager.GetTodayTasks()
 	if err != nil {
 		printError(err)
@@ -717,7 +679,7 @@ func generateworkflowReport(taskManager *core.TaskManagerSQLite) {
 
 	totalHours := taskManager.GetTotalHours(todayTasks)
 
-	fmt.Printf("📋 workflow Report for %s\n", time.Now().Format("2006-01-02"))
+	fmt.Printf("📋 workflow Report for %s\n", core.Today())
 	fmt.Printf("Total hours: %.2fh\n\n", totalHours)
 
 	fmt.Println("Copy the following lines to workflow:")
@@ -737,279 +699,82 @@ func generateworkflowReport(taskManager *core.TaskManagerSQLite) {
 	}
 }
 
-// performDetailedReport ejecuta la generación del reporte detallado
-func performDetailedReport(date string, week bool, month bool, category string, status string) {
-	taskManager := core.NewTaskManagerSQLite()
+// performDetailedReport construye el reporte del período y lo presenta en el formato indicado.
+// Sin archivo de salida el reporte se escribe en la terminal.
+func performDetailedReport(reportPeriod period, filter core.TaskFilter, format string, output string) {
+	taskManager := core.NewTaskStore()
 	defer taskManager.Close()
 
-	var tasks []workflow.Task
-	var err error
-
-	// Determinar período del reporte
-	if date != "" {
-		// Reporte de fecha específica
-		tasks, err = taskManager.GetTasksByDate(date)
-		if err != nil {
-			printError(fmt.Errorf("could not load tasks for date %s: %v", date, err))
-			return
-		}
-		generateDateReport(date, tasks, category, status)
-	} else if week {
-		// Reporte semanal
-		startDate := getWeekStart()
-		endDate := getWeekEnd()
-		tasks, err = taskManager.SearchTasks("", category, status, "")
-		if err != nil {
-			printError(fmt.Errorf("could not load tasks: %v", err))
-			return
-		}
-		// Filtrar por semana
-		var weekTasks []workflow.Task
-		for _, task := range tasks {
-			if task.Date >= startDate && task.Date <= endDate {
-				weekTasks = append(weekTasks, task)
-			}
-		}
-		generateWeekReport(startDate, endDate, weekTasks, category, status)
-	} else if month {
-		// Reporte mensual
-		startDate := getMonthStart()
-		endDate := getMonthEnd()
-		tasks, err = taskManager.SearchTasks("", category, status, "")
-		if err != nil {
-			printError(fmt.Errorf("could not load tasks: %v", err))
-			return
-		}
-		// Filtrar por mes
-		var monthTasks []workflow.Task
-		for _, task := range tasks {
-			if task.Date >= startDate && task.Date <= endDate {
-				monthTasks = append(monthTasks, task)
-			}
-		}
-		generateMonthReport(startDate, endDate, monthTasks, category, status)
-	} else {
-		// Reporte de hoy por defecto
-		today := time.Now().Format("2006-01-02")
-		tasks, err = taskManager.GetTasksByDate(today)
-		if err != nil {
-			printError(fmt.Errorf("could not load today's tasks: %v", err))
-			return
-		}
-		generateDateReport(today, tasks, category, status)
-	}
-}
-
-// generateDateReport genera reporte para una fecha específica
-func generateDateReport(date string, tasks []workflow.Task, category string, status string) {
-	fmt.Printf("📊 Report for %s\n", date)
-	fmt.Printf("%s\n", strings.Repeat("=", 50))
-
-	if len(tasks) == 0 {
-		fmt.Println("📝 No tasks found for this date.")
-		return
-	}
+	filter.From = reportPeriod.From
+	filter.To = reportPeriod.To
 
-	// Aplicar filtros
-	var filteredTasks []workflow.Task
-	for _, task := range tasks {
-		if category != "" && task.Category != category {
-			continue
-		}
-		if status != "" && task.Status != status {
-			continue
-		}
-		filteredTasks = append(filteredTasks, task)
-	}
-
-	if len(filteredTasks) == 0 {
-		fmt.Println("📝 No tasks match the specified filters.")
+	tasks, err := taskManager.SearchTasksInRange(filter)
+	if err != nil {
+		printError(fmt.Errorf("could not load tasks for %s: %v", reportPeriod.Label(), err))
 		return
 	}
 
-	// Mostrar tareas
-	fmt.Printf("📋 Tasks (%d):\n", len(filteredTasks))
-	for _, task := range filteredTasks {
-		fmt.Printf("[%d] %s %s (%.1fh, %s) %s\n",
-			task.ID,
-			workflow.GetIcon(task.Category),
-			task.Description,
-			task.Hours,
-			task.Category,
-			workflow.GetStatusIcon(task.Status))
+	kind := reportPeriod.Kind
+	if kind == periodToday {
+		kind = periodDate
 	}
+	report := core.NewReport(kind, reportPeriod.From, reportPeriod.To, tasks)
 
-	// Estadísticas
-	totalHours := 0.0
-	completedHours := 0.0
-	pendingHours := 0.0
-	categoryStats := make(map[string]float64)
-
-	for _, task := range filteredTasks {
-		totalHours += task.Hours
-		categoryStats[task.Category] += task.Hours
-
-		if task.Status == workflow.StatusCompleted {
-			completedHours += task.Hours
-		} else {
-			pendingHours += task.Hours
-		}
+	// Las horas esperadas se comparan en semanas y en rangos con ambos límites
+	if len(tasks) > 0 && kind != periodDate && reportPeriod.From != "" && reportPeriod.To != "" {
+		report.Expected = reportExpected(reportPeriod.From, reportPeriod.To, report.TotalHours)
 	}
 
-	fmt.Printf("\n📈 Statistics:\n")
-	fmt.Printf("Total hours: %.1fh\n", totalHours)
-	fmt.Printf("Completed: %.1fh\n", completedHours)
-	fmt.Printf("Pending: %.1fh\n", pendingHours)
-
-	if len(categoryStats) > 1 {
-		fmt.Printf("\n📊 By category:\n")
-		for category, hours := range categoryStats {
-			fmt.Printf("  %s: %.1fh\n", category, hours)
+	renderer := reportRenderers[format]
+	if output == "" {
+		if err := renderer.Render(os.Stdout, report); err != nil {
+			printError(fmt.Errorf("could not render report: %v", err))
 		}
-	}
-}
-
-// generateWeekReport genera reporte semanal
-func generateWeekReport(startDate, endDate string, tasks []workflow.Task, category string, status string) {
-	fmt.Printf("📊 Weekly Report (%s to %s)\n", startDate, endDate)
-	fmt.Printf("%s\n", strings.Repeat("=", 50))
-
-	if len(tasks) == 0 {
-		fmt.Println("📝 No tasks found for this week.")
 		return
 	}
 
-	// Agrupar por fecha
-	dateGroups := make(map[string][]workflow.Task)
-	for _, task := range tasks {
-		dateGroups[task.Date] = append(dateGroups[task.Date], task)
-	}
-
-	// Mostrar por día
-	for date := startDate; date <= endDate; date = addDays(date, 1) {
-		if dayTasks, exists := dateGroups[date]; exists {
-			fmt.Printf("\n📅 %s:\n", date)
-			totalDayHours := 0.0
-			for _, task := range dayTasks {
-				fmt.Printf("  [%d] %s %s (%.1fh, %s) %s\n",
-					task.ID,
-					workflow.GetIcon(task.Category),
-					task.Description,
-					task.Hours,
-					task.Category,
-					workflow.GetStatusIcon(task.Status))
-				totalDayHours += task.Hours
-			}
-			fmt.Printf("  Total: %.1fh\n", totalDayHours)
-		}
-	}
-
-	// Estadísticas semanales
-	totalHours := 0.0
-	completedHours := 0.0
-	categoryStats := make(map[string]float64)
-
-	for _, task := range tasks {
-		totalHours += task.Hours
-		categoryStats[task.Category] += task.Hours
-		if task.Status == workflow.StatusCompleted {
-			completedHours += task.Hours
-		}
-	}
-
-	fmt.Printf("\n📈 Weekly Summary:\n")
-	fmt.Printf("Total hours: %.1fh\n", totalHours)
-	fmt.Printf("Completed: %.1fh\n", completedHours)
-	fmt.Printf("Completion rate: %.1f%%\n", (completedHours/totalHours)*100)
-
-	if len(categoryStats) > 1 {
-		fmt.Printf("\n📊 By category:\n")
-		for category, hours := range categoryStats {
-			fmt.Printf("  %s: %.1fh\n", category, hours)
-		}
-	}
-}
-
-// generateMonthReport genera reporte mensual
-func generateMonthReport(startDate, endDate string, tasks []workflow.Task, category string, status string) {
-	fmt.Printf("📊 Monthly Report (%s to %s)\n", startDate, endDate)
-	fmt.Printf("%s\n", strings.Repeat("=", 50))
-
-	if len(tasks) == 0 {
-		fmt.Println("📝 No tasks found for this month.")
+	file, err := os.Create(output)
+	if err != nil {
+		printError(fmt.Errorf("could not create report file: %v", err))
 		return
 	}
+	defer file.Close()
 
-	// Estadísticas mensuales
-	totalHours := 0.0
-	completedHours := 0.0
-	categoryStats := make(map[string]float64)
-	statusStats := make(map[string]int)
-
-	for _, task := range tasks {
-		totalHours += task.Hours
-		categoryStats[task.Category] += task.Hours
-		statusStats[task.Status]++
-
-		if task.Status == workflow.StatusCompleted {
-			completedHours += task.Hours
-		}
-	}
-
-	fmt.Printf("📈 Monthly Summary:\n")
-	fmt.Printf("Total hours: %.1fh\n", totalHours)
-	fmt.Printf("Completed: %.1fh\n", completedHours)
-	fmt.Printf("Completion rate: %.1f%%\n", (completedHours/totalHours)*100)
-	fmt.Printf("Total tasks: %d\n", len(tasks))
-
-	fmt.Printf("\n📊 By category:\n")
-	for category, hours := range categoryStats {
-		fmt.Printf("  %s: %.1fh\n", category, hours)
+	if err := renderer.Render(file, report); err != nil {
+		printError(fmt.Errorf("could not render report: %v", err))
+		return
 	}
 
-	fmt.Printf("\n📊 By status:\n")
-	for status, count := range statusStats {
-		fmt.Printf("  %s: %d tasks\n", status, count)
-	}
+	absPath, _ := filepath.Abs(output)
+	printSuccess(fmt.Sprintf("Report written to %s", absPath))
 }
 
 // Funciones auxiliares para fechas
 func getWeekStart() string {
-	now := time.Now()
-	weekday := now.Weekday()
-	if weekday == time.Sunday {
-		weekday = 7
-	} else {
-		weekday--
-	}
-	weekStart := now.AddDate(0, 0, -int(weekday))
-	return weekStart.Format("2006-01-02")
+	return currentWeekStart().Format("2006-01-02")
 }
 
 func getWeekEnd() string {
-	weekStart, _ := time.Parse("2006-01-02", getWeekStart())
-	weekEnd := weekStart.AddDate(0, 0, 6)
-	return weekEnd.Format("2006-01-02")
+	return currentWeekStart().AddDate(0, 0, 6).Format("2006-01-02")
+}
+
+// currentWeekStart devuelve el primer día de la semana actual según el calendario laboral
+func currentWeekStart() time.Time {
+	return workCalendar().StartOfWeek(core.Now())
 }
 
 func getMonthStart() string {
-	now := time.Now()
+	now := core.Now()
 	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
 	return monthStart.Format("2006-01-02")
 }
 
 func getMonthEnd() string {
-	now := time.Now()
+	now := core.Now()
 	monthEnd := time.Date(now.Year(), now.Month()+1, 0, 0, 0, 0, 0, now.Location())
 	return monthEnd.Format("2006-01-02")
 }
 
-func addDays(date string, days int) string {
-	t, _ := time.Parse("2006-01-02", date)
-	newDate := t.AddDate(0, 0, days)
-	return newDate.Format("2006-01-02")
-}
-
 // copyToClipboard intenta copiar el reporte al portapapeles
 func copyToClipboard(tasks []workflow.Task) error {
 	// Construir el texto del reporte
@@ -1157,20 +922,32 @@ var listCmd = &cobra.Command{
 Examples:
   workflow list
   workflow list --date 2025-07-20
+  workflow list --date yesterday
+  workflow list --date mon
+  workflow list --date 2025-W30
 `,
 	Run: func(cmd *cobra.Command, args []string) {
-		taskManager := core.NewTaskManagerSQLite()
+		taskManager := core.NewTaskStore()
 		defer taskManager.Close()
-		date, _ := cmd.Flags().GetString("date")
-		if date == "" {
-			date = time.Now().Format("2006-01-02")
+		dateFlag, _ := cmd.Flags().GetString("date")
+		if dateFlag == "" {
+			dateFlag = "today"
 		}
-		tasks, err := taskManager.GetTasksByDate(date)
+		dateRange, err := core.ParseDateRange(dateFlag, core.Now())
 		if err != nil {
 			printError(err)
 			return
 		}
-		fmt.Printf("\n📅 Tasks for %s:\n", date)
+		tasks, err := taskManager.SearchTasksInRange(core.TaskFilter{From: dateRange.From, To: dateRange.To})
+		if err != nil {
+			printError(err)
+			return
+		}
+		label := dateRange.From
+		if !dateRange.IsSingleDay() {
+			label = fmt.Sprintf("%s to %s", dateRange.From, dateRange.To)
+		}
+		fmt.Printf("\n📅 Tasks for %s:\n", label)
 		if len(tasks) == 0 {
 			fmt.Println("  No tasks found.")
 			return
@@ -1178,51 +955,62 @@ Examples:
 		for _, task := range tasks {
 			icon := workflow.GetIcon(task.Category)
 			statusIcon := workflow.GetStatusIcon(task.Status)
-			fmt.Printf("  [%d] %s %s (%.1fh, %s) %s\n", task.ID, icon, task.Description, task.Hours, task.Category, statusIcon)
+			fmt.Printf("  [%d] %s %s (%.1fh, %s) %s%s\n", task.ID, icon, task.Description, task.Hours, task.Category, statusIcon, formatTags(task.Tags))
 		}
 	},
 }
 
 // editCmd es el comando para editar tareas
 var editCmd = &cobra.Command{
-	Use:   "edit [id]",
-	Short: "Edit a task by ID",
-	Long: `Edit an existing task by its ID.
+	Use:   "edit <id|range>... [flags]",
+	Short: "Edit one or more tasks",
+	Long: `Edit existing tasks by ID, ID range, or filters.
+When several tasks are selected, a preview is shown and one confirmation is asked.
+Changing the status of the running timer's task to paused or in_progress pauses or
+resumes the timer.
+
+` + selectionHelp + `
 
 Examples:
   workflow edit 1 --description "New description"
   workflow edit 2 --hours 3.5
   workflow edit 3 --category tech
+  workflow edit 4 --project "Website redesign"
+  workflow edit 5 --tag sprint-42 --untag sprint-41
+  workflow edit 6 --no-billable
+  workflow edit 7 --status paused
   workflow edit 1 --description "New desc" --hours 2.0 --category meeting
+  workflow edit 12-18 --category meeting
+  workflow edit --filter-from 2025-07-21 --filter-to 2025-07-25 --filter-category doc --category research
 `,
-	Args: cobra.ExactArgs(1),
+	Args: cobra.ArbitraryArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		idStr := args[0]
-		id, err := strconv.Atoi(idStr)
+		// Obtener nuevos valores de los flags
+		description, _ := cmd.Flags().GetString("description")
+		hoursStr, _ := cmd.Flags().GetString("hours")
+		category, _ := cmd.Flags().GetString("category")
+		status, _ := cmd.Flags().GetString("status")
+		addTags, _ := cmd.Flags().GetStringArray("tag")
+		removeTags, _ := cmd.Flags().GetStringArray("untag")
+		projectChanged := cmd.Flags().Changed("project")
+		project, _ := cmd.Flags().GetString("project")
+		billable, billableChanged, err := readBillableFlags(cmd)
 		if err != nil {
-			printError(fmt.Errorf("invalid task ID: %s", idStr))
+			printError(err)
 			return
 		}
 
-		taskManager := core.NewTaskManagerSQLite()
-		defer taskManager.Close()
-
-		// Obtener la tarea actual para mostrar información
-		task, err := taskManager.GetTaskByID(id)
-		if err != nil {
-			printError(err)
+		if description == "" && hoursStr == "" && category == "" && status == "" && !projectChanged && !billableChanged && len(addTags) == 0 && len(removeTags) == 0 {
+			printError(fmt.Errorf("nothing to change: use --description, --hours, --category, --status, --project, --billable, --no-billable, --tag or --untag"))
 			return
 		}
 
-		// Mostrar información actual
-		icon := workflow.GetIcon(task.Category)
-		fmt.Printf("✏️  Editing task:\n")
-		fmt.Printf("[%d] %s %s (%.1fh, %s)\n\n", task.ID, icon, task.Description, task.Hours, task.Category)
-
-		// Obtener nuevos valores de los flags
-		description, _ := cmd.Flags().GetString("description")
-		hoursStr, _ := cmd.Flags().GetString("hours")
-		category, _ := cmd.Flags().GetString("category")
+		if status != "" {
+			if err := core.ValidateStatus(status); err != nil {
+				printError(err)
+				return
+			}
+		}
 
 		// Parsear horas si se proporcionó
 		var hours float64
@@ -1234,146 +1022,187 @@ Examples:
 			}
 		}
 
-		// Actualizar la tarea
-		if err := taskManager.UpdateTask(id, description, hours, category); err != nil {
+		taskManager := core.NewTaskStore()
+		defer taskManager.Close()
+
+		if category != "" {
+			if err := taskManager.ValidateCategory(category); err != nil {
+				printError(err)
+				return
+			}
+		}
+
+		tasks, err := selectTasks(cmd, args, taskManager)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		// Aplicar los cambios indicados a cada tarea
+		var updated []*workflow.Task
+		for _, original := range tasks {
+			task := original
+			if description != "" {
+				task.Description = description
+			}
+			if hours > 0 {
+				task.Hours = hours
+			}
+			if category != "" {
+				task.Category = category
+			}
+			if status != "" {
+				task.Status = status
+			}
+			if projectChanged {
+				task.Project = project
+			}
+			if billableChanged {
+				task.Billable = billable
+			}
+
+			task.Tags, err = editTags(original.Tags, addTags, removeTags)
+			if err != nil {
+				printError(err)
+				return
+			}
+
+			updated = append(updated, &task)
+		}
+
+		// Mostrar información actual
+		previewTasks("✏️  Editing:", tasks)
+
+		if len(tasks) > 1 && !confirm(cmd, fmt.Sprintf("Apply these changes to %d tasks?", len(tasks))) {
+			printInfo("Edit cancelled")
+			return
+		}
+
+		// Actualizar las tareas
+		if err := taskManager.ApplyBatch(core.TaskBatch{Update: updated}); err != nil {
 			printError(err)
 			return
 		}
 
-		printSuccess(fmt.Sprintf("Task %d updated successfully", id))
+		printSuccess(fmt.Sprintf("Updated %s", pluralTasks(tasks)))
 
-		// Mostrar la tarea actualizada
-		updatedTask, _ := taskManager.GetTaskByID(id)
-		if updatedTask != nil {
-			icon := workflow.GetIcon(updatedTask.Category)
-			fmt.Printf("Updated: [%d] %s %s (%.1fh, %s)\n",
-				updatedTask.ID, icon, updatedTask.Description, updatedTask.Hours, updatedTask.Category)
+		// Mostrar las tareas actualizadas
+		for _, task := range updated {
+			fmt.Printf("Updated: [%d] %s %s (%.1fh, %s)%s\n",
+				task.ID, workflow.GetIcon(task.Category), task.Description, task.Hours, task.Category, formatTags(task.Tags))
 		}
 	},
 }
 
 // deleteCmd es el comando para eliminar tareas
 var deleteCmd = &cobra.Command{
-	Use:   "delete [id]",
-	Short: "Delete a task by ID",
-	Long: `Delete an existing task by its ID.
+	Use:   "delete <id|range>... [flags]",
+	Short: "Move one or more tasks to the trash",
+	Long: `Delete existing tasks by ID, ID range, or filters. Tasks are moved to the trash
+and can be brought back with 'workflow restore <id>'.
+
+` + selectionHelp + `
 
 Examples:
   workflow delete 1
   workflow delete 2 --force
+  workflow delete 4 7 12-18
+  workflow delete --filter-date 2025-07-21 --filter-category meeting
 `,
-	Args: cobra.ExactArgs(1),
+	Args: cobra.ArbitraryArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		idStr := args[0]
-		id, err := strconv.Atoi(idStr)
-		if err != nil {
-			printError(fmt.Errorf("invalid task ID: %s", idStr))
-			return
-		}
-
-		taskManager := core.NewTaskManagerSQLite()
+		taskManager := core.NewTaskStore()
 		defer taskManager.Close()
 
-		// Obtener la tarea para mostrar información
-		task, err := taskManager.GetTaskByID(id)
+		tasks, err := selectTasks(cmd, args, taskManager)
 		if err != nil {
 			printError(err)
 			return
 		}
 
-		// Mostrar información de la tarea a eliminar
-		icon := workflow.GetIcon(task.Category)
-		fmt.Printf("️  Deleting task:\n")
-		fmt.Printf("[%d] %s %s (%.1fh, %s)\n\n", task.ID, icon, task.Description, task.Hours, task.Category)
-
-		// Verificar si se debe forzar la eliminación
-		force, _ := cmd.Flags().GetBool("force")
+		// Mostrar información de las tareas a eliminar
+		previewTasks("🗑️  Deleting:", tasks)
 
-		if !force {
-			fmt.Print("Are you sure you want to delete this task? (y/N): ")
-			var response string
-			fmt.Scanln(&response)
+		if !confirm(cmd, fmt.Sprintf("Are you sure you want to delete %s?", pluralTasks(tasks))) {
+			printInfo("Deletion cancelled")
+			return
+		}
 
-			if strings.ToLower(strings.TrimSpace(response)) != "y" &&
-				strings.ToLower(strings.TrimSpace(response)) != "yes" {
-				printInfo("Deletion cancelled")
-				return
-			}
+		ids := make([]int, len(tasks))
+		for i, task := range tasks {
+			ids[i] = task.ID
 		}
 
-		// Eliminar la tarea
-		if err := taskManager.DeleteTask(id); err != nil {
+		// Enviar las tareas a la papelera
+		if err := taskManager.ApplyBatch(core.TaskBatch{Delete: ids}); err != nil {
 			printError(err)
 			return
 		}
 
-		printSuccess(fmt.Sprintf("Task %d deleted successfully", id))
+		printSuccess(fmt.Sprintf("Moved %s to trash (restore with 'workflow restore <id>')", pluralTasks(tasks)))
 	},
 }
 
 // completeCmd es el comando para marcar tareas como completadas
 var completeCmd = &cobra.Command{
-	Use:   "complete [id]",
-	Short: "Mark a task as completed",
-	Long: `Mark an existing task as completed by its ID.
+	Use:   "complete <id|range>... [flags]",
+	Short: "Mark one or more tasks as completed",
+	Long: `Mark existing tasks as completed by ID, ID range, or filters.
+Completing the running timer's task pauses the timer; run 'workflow stop' to record its hours.
+
+` + selectionHelp + `
 
 Examples:
   workflow complete 1
   workflow complete 2 --force
+  workflow complete 12-18
+  workflow complete --filter-date 2025-07-21 --filter-status in_progress
 `,
-	Args: cobra.ExactArgs(1),
+	Args: cobra.ArbitraryArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		idStr := args[0]
-		id, err := strconv.Atoi(idStr)
-		if err != nil {
-			printError(fmt.Errorf("invalid task ID: %s", idStr))
-			return
-		}
-
-		taskManager := core.NewTaskManagerSQLite()
+		taskManager := core.NewTaskStore()
 		defer taskManager.Close()
 
-		// Obtener la tarea para mostrar información
-		task, err := taskManager.GetTaskByID(id)
+		selected, err := selectTasks(cmd, args, taskManager)
 		if err != nil {
 			printError(err)
 			return
 		}
 
-		// Verificar si ya está completada
-		if task.Status == workflow.StatusCompleted {
-			printInfo(fmt.Sprintf("Task %d is already completed", id))
-			return
-		}
+		// Omitir las tareas ya completadas
+		var tasks []workflow.Task
+		var updated []*workflow.Task
+		for _, task := range selected {
+			if task.Status == workflow.StatusCompleted {
+				printInfo(fmt.Sprintf("Task %d is already completed", task.ID))
+				continue
+			}
+			tasks = append(tasks, task)
 
-		// Mostrar información de la tarea a completar
-		icon := workflow.GetIcon(task.Category)
-		statusIcon := workflow.GetStatusIcon(task.Status)
-		fmt.Printf("✅ Completing task:\n")
-		fmt.Printf("[%d] %s %s (%.1fh, %s) %s\n\n", task.ID, icon, task.Description, task.Hours, task.Category, statusIcon)
+			completed := task
+			completed.Status = workflow.StatusCompleted
+			updated = append(updated, &completed)
+		}
 
-		// Verificar si se debe forzar la completación
-		force, _ := cmd.Flags().GetBool("force")
+		if len(tasks) == 0 {
+			return
+		}
 
-		if !force {
-			fmt.Print("Are you sure you want to mark this task as completed? (y/N): ")
-			var response string
-			fmt.Scanln(&response)
+		// Mostrar información de las tareas a completar
+		previewTasks("✅ Completing:", tasks)
 
-			if strings.ToLower(strings.TrimSpace(response)) != "y" &&
-				strings.ToLower(strings.TrimSpace(response)) != "yes" {
-				printInfo("Completion cancelled")
-				return
-			}
+		if !confirm(cmd, fmt.Sprintf("Are you sure you want to mark %s as completed?", pluralTasks(tasks))) {
+			printInfo("Completion cancelled")
+			return
 		}
 
-		// Marcar como completada
-		if err := taskManager.CompleteTask(id); err != nil {
+		// Marcar como completadas
+		if err := taskManager.ApplyBatch(core.TaskBatch{Update: updated}); err != nil {
 			printError(err)
 			return
 		}
 
-		printSuccess(fmt.Sprintf("Task %d marked as completed", id))
+		printSuccess(fmt.Sprintf("Marked %s as completed", pluralTasks(tasks)))
 	},
 }
 
@@ -1382,6 +1211,7 @@ var searchCmd = &cobra.Command{
 	Use:   "search [query]",
 	Short: "Search tasks by text, category, status, or date",
 	Long: `Search tasks using various criteria.
+A task is listed under each day it was worked, with the hours of that day.
 
 Examples:
   workflow search "bug"
@@ -1389,6 +1219,8 @@ Examples:
   workflow search "" --status completed
   workflow search "development" --category tech --status pending
   workflow search "test" --date 2025-07-21
+  workflow search --tag sprint-42 --tag billable
+  workflow search --any-tag JIRA-101 --any-tag JIRA-102
 `,
 	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -1400,16 +1232,42 @@ Examples:
 		// Obtener filtros de los flags
 		category, _ := cmd.Flags().GetString("category")
 		status, _ := cmd.Flags().GetString("status")
-		date, _ := cmd.Flags().GetString("date")
+		dateFlag, _ := cmd.Flags().GetString("date")
+		tags, anyTags, err := readTagFilters(cmd)
+		if err != nil {
+			printError(err)
+			return
+		}
+		dateRange, err := parseDateRangeFlag(dateFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
 
-		taskManager := core.NewTaskManagerSQLite()
+		taskManager := core.NewTaskStore()
 		defer taskManager.Close()
-		tasks, err := taskManager.SearchTasks(query, category, status, date)
+		// Cada tarea aparece en los días en que se trabajó, con las horas de ese día
+		filter := core.TaskFilter{
+			From:     dateRange.From,
+			To:       dateRange.To,
+			Category: category,
+			Status:   status,
+			Query:    query,
+			Tags:     tags,
+			AnyTags:  anyTags,
+		}
+		worked, err := taskManager.SearchTasksInRange(filter)
 		if err != nil {
 			printError(err)
 			return
 		}
 
+		// Mostrar primero los días más recientes
+		tasks := make([]workflow.Task, 0, len(worked))
+		for i := len(worked) - 1; i >= 0; i-- {
+			tasks = append(tasks, worked[i])
+		}
+
 		// Construir mensaje de búsqueda
 		var searchTerms []string
 		if query != "" {
@@ -1421,8 +1279,18 @@ Examples:
 		if status != "" {
 			searchTerms = append(searchTerms, fmt.Sprintf("status: '%s'", status))
 		}
-		if date != "" {
-			searchTerms = append(searchTerms, fmt.Sprintf("date: '%s'", date))
+		if dateFlag != "" {
+			dateLabel := dateRange.From
+			if !dateRange.IsSingleDay() {
+				dateLabel = fmt.Sprintf("%s to %s", dateRange.From, dateRange.To)
+			}
+			searchTerms = append(searchTerms, fmt.Sprintf("date: '%s'", dateLabel))
+		}
+		if len(tags) > 0 {
+			searchTerms = append(searchTerms, fmt.Sprintf("tags: '%s'", strings.Join(tags, "' and '")))
+		}
+		if len(anyTags) > 0 {
+			searchTerms = append(searchTerms, fmt.Sprintf("tags: '%s'", strings.Join(anyTags, "' or '")))
 		}
 
 		searchDescription := "all tasks"
@@ -1447,10 +1315,10 @@ Examples:
 
 			icon := workflow.GetIcon(task.Category)
 			statusIcon := workflow.GetStatusIcon(task.Status)
-			fmt.Printf("  [%d] %s %s (%.1fh, %s) %s\n",
-				task.ID, icon, task.Description, task.Hours, task.Category, statusIcon)
+			fmt.Printf("  [%d] %s %s (%.1fh, %s) %s%s\n",
+				task.ID, icon, task.Description, task.Hours, task.Category, statusIcon, formatTags(task.Tags))
 		}
 
-		fmt.Printf("\n📊 Found %d task(s)\n", len(tasks))
+		fmt.Printf("\n📊 Found %d task(s)\n", len(workedTaskIDs(tasks)))
 	},
 }
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+// dbCmd agrupa los comandos de mantenimiento de la base de datos
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Database maintenance commands",
+	Long: `Database maintenance commands for the SQLite task database.
+
+Examples:
+  workflow db migrate
+  workflow db migrate --status
+`,
+}
+
+// dbMigrateCmd aplica las migraciones pendientes del esquema
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply pending schema migrations",
+	Long: `Apply pending schema migrations to the SQLite database.
+
+Migrations are also applied automatically every time the database is opened.
+Use --status to show which migrations have been applied.
+
+Examples:
+  workflow db migrate
+  workflow db migrate --status
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		statusFlag, _ := cmd.Flags().GetBool("status")
+
+		// Abrir la base de datos aplica las migraciones pendientes
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		if statusFlag {
+			showMigrationStatus(taskManager)
+			return
+		}
+
+		version, err := taskManager.GetSchemaVersion()
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Database schema is up to date (version %d)", version))
+	},
+}
+
+// showMigrationStatus muestra el estado de cada migración
+func showMigrationStatus(taskManager *core.TaskManagerSQLite) {
+	statuses, err := taskManager.GetMigrationStatus()
+	if err != nil {
+		printError(err)
+		return
+	}
+
+	fmt.Printf("🗄️  Database: %s\n", taskManager.GetDatabasePath())
+	fmt.Println(strings.Repeat("─", 50))
+
+	for _, status := range statuses {
+		if status.Applied {
+			fmt.Printf("✅ %3d  %-30s applied %s\n", status.Version, status.Description, status.AppliedAt.Format("2006-01-02 15:04:05"))
+		} else {
+			fmt.Printf("⏳ %3d  %-30s pending\n", status.Version, status.Description)
+		}
+	}
+}
+
+func init() {
+	dbMigrateCmd.Flags().Bool("status", false, "Show applied and pending migrations")
+	dbCmd.AddCommand(dbMigrateCmd)
+}
@@ -21,26 +21,24 @@ func parseTaskID(idStr string) int {
 
 // duplicateCmd es el comando para duplicar tareas
 var duplicateCmd = &cobra.Command{
-	Use:   "duplicate <task-id>",
-	Short: "Duplicate an existing task",
-	Long: `Duplicate an existing task with the same description, hours, and category.
+	Use:   "duplicate <task-id|range>... [flags]",
+	Short: "Duplicate one or more existing tasks",
+	Long: `Duplicate existing tasks with the same description, hours, and category.
 
-You can specify a different date for the duplicated task using flags.
+You can specify a different date for the duplicated tasks using flags.
+
+` + selectionHelp + `
 
 Examples:
   workflow duplicate 1
   workflow duplicate 1 --date 2025-07-22
   workflow duplicate 1 --tomorrow
   workflow duplicate 1 --yesterday
+  workflow duplicate 12-15 --tomorrow
+  workflow duplicate --filter-date 2025-07-21 --filter-category meeting --date 2025-07-28
 `,
-	Args: cobra.ExactArgs(1),
+	Args: cobra.ArbitraryArgs,
 	Run: func(cmd *cobra.Command, args []string) {
-		taskID := parseTaskID(args[0])
-		if taskID == -1 {
-			printError(fmt.Errorf("invalid task ID: %s", args[0]))
-			return
-		}
-
 		// Obtener flags
 		dateFlag, _ := cmd.Flags().GetString("date")
 		yesterdayFlag, _ := cmd.Flags().GetBool("yesterday")
@@ -63,85 +61,93 @@ Examples:
 			return
 		}
 
-		// Determinar fecha para la tarea duplicada
-		targetDate := ""
-		if dateFlag != "" {
-			targetDate = dateFlag
-		} else if yesterdayFlag {
-			targetDate = time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+		// Determinar fecha para las tareas duplicadas; --yesterday y --tomorrow son atajos de --date
+		if yesterdayFlag {
+			dateFlag = "yesterday"
 		} else if tomorrowFlag {
-			targetDate = time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+			dateFlag = "tomorrow"
+		}
+
+		targetDate, err := parseDateFlag(dateFlag)
+		if err != nil {
+			printError(err)
+			return
 		}
 
-		performDuplicate(taskID, targetDate)
+		performDuplicate(cmd, args, targetDate)
 	},
 }
 
-// performDuplicate ejecuta la duplicación de la tarea
-func performDuplicate(taskID int, targetDate string) {
-	taskManager := core.NewTaskManagerSQLite()
+// performDuplicate ejecuta la duplicación de las tareas seleccionadas
+func performDuplicate(cmd *cobra.Command, args []string, targetDate string) {
+	taskManager := core.NewTaskStore()
 	defer taskManager.Close()
 
-	// Obtener la tarea original
-	originalTask, err := taskManager.GetTaskByID(taskID)
+	// Obtener las tareas originales
+	originalTasks, err := selectTasks(cmd, args, taskManager)
 	if err != nil {
-		printError(fmt.Errorf("could not find task %d: %v", taskID, err))
+		printError(err)
 		return
 	}
 
-	// Mostrar tarea original
-	fmt.Printf("📋 Original task:\n")
-	fmt.Printf("[%d] %s %s (%.1fh, %s) %s\n",
-		originalTask.ID,
-		workflow.GetIcon(originalTask.Category),
-		originalTask.Description,
-		originalTask.Hours,
-		originalTask.Category,
-		workflow.GetStatusIcon(originalTask.Status))
-
-	// Crear nueva tarea
-	newTask := &workflow.Task{
-		Description: originalTask.Description,
-		Hours:       originalTask.Hours,
-		Category:    originalTask.Category,
-		Status:      workflow.StatusPending, // Siempre pendiente al duplicar
-		CreatedAt:   time.Now(),
+	// Hoy por defecto
+	if targetDate == "" {
+		targetDate = core.Today()
 	}
 
-	// Establecer fecha
-	if targetDate != "" {
-		newTask.Date = targetDate
-	} else {
-		newTask.Date = time.Now().Format("2006-01-02") // Hoy por defecto
+	// Mostrar tareas originales
+	previewTasks(fmt.Sprintf("📋 Duplicating to %s:", targetDate), originalTasks)
+
+	if len(originalTasks) > 1 && !confirm(cmd, fmt.Sprintf("Duplicate %d tasks?", len(originalTasks))) {
+		printInfo("Duplication cancelled")
+		return
 	}
 
-	// Guardar nueva tarea
-	if err := taskManager.SaveTaskToDatabase(newTask); err != nil {
-		printError(fmt.Errorf("could not duplicate task: %v", err))
+	// Crear nuevas tareas
+	var newTasks []*workflow.Task
+	for _, originalTask := range originalTasks {
+		newTasks = append(newTasks, &workflow.Task{
+			Description: originalTask.Description,
+			Hours:       originalTask.Hours,
+			Category:    originalTask.Category,
+			Date:        targetDate,
+			Status:      workflow.StatusPending, // Siempre pendiente al duplicar
+			CreatedAt:   time.Now().UTC(),
+			Project:     originalTask.Project,
+			Tags:        originalTask.Tags,
+			Billable:    originalTask.Billable,
+		})
+	}
+
+	// Guardar nuevas tareas
+	if err := taskManager.ApplyBatch(core.TaskBatch{Create: newTasks}); err != nil {
+		printError(fmt.Errorf("could not duplicate tasks: %v", err))
 		return
 	}
 
 	// Mostrar confirmación
-	fmt.Printf("\n✅ Task duplicated successfully!\n")
-	fmt.Printf("📝 New task:\n")
-	fmt.Printf("[%d] %s %s (%.1fh, %s) %s - %s\n",
-		newTask.ID,
-		workflow.GetIcon(newTask.Category),
-		newTask.Description,
-		newTask.Hours,
-		newTask.Category,
-		workflow.GetStatusIcon(newTask.Status),
-		newTask.Date)
+	fmt.Printf("✅ Duplicated %d task(s) successfully!\n", len(newTasks))
+	fmt.Printf("📝 New tasks:\n")
+	for _, newTask := range newTasks {
+		fmt.Printf("[%d] %s %s (%.1fh, %s) %s - %s\n",
+			newTask.ID,
+			workflow.GetIcon(newTask.Category),
+			newTask.Description,
+			newTask.Hours,
+			newTask.Category,
+			workflow.GetStatusIcon(newTask.Status),
+			newTask.Date)
+	}
 
 	// Mostrar estado actualizado
-	if newTask.Date == time.Now().Format("2006-01-02") {
+	if targetDate == core.Today() {
 		fmt.Printf("\n📊 Today's Status:\n")
 		todayTasks, _ := taskManager.GetTodayTasks()
 		totalHours := taskManager.GetTotalHours(todayTasks)
-		targetHours := taskManager.GetDailyHoursTarget()
+		targetHours, _ := calendarWithLeave(targetDate, targetDate).ExpectedHours(targetDate)
 		remaining := targetHours - totalHours
 
-		fmt.Printf("📅 Today (%s): %.1fh / %.1fh\n", newTask.Date, totalHours, targetHours)
+		fmt.Printf("📅 Today (%s): %.1fh / %.1fh\n", targetDate, totalHours, targetHours)
 		if remaining > 0 {
 			fmt.Printf("📈 Remaining: %.1fh\n", remaining)
 		} else {
@@ -152,7 +158,9 @@ func performDuplicate(taskID int, targetDate string) {
 
 func init() {
 	// Flags para duplicate
-	duplicateCmd.Flags().String("date", "", "Target date for duplicated task (format: YYYY-MM-DD)")
+	duplicateCmd.Flags().String("date", "", "Target date for duplicated task ("+dateFlagHelp+")")
 	duplicateCmd.Flags().Bool("yesterday", false, "Duplicate task for yesterday")
 	duplicateCmd.Flags().Bool("tomorrow", false, "Duplicate task for tomorrow")
+	duplicateCmd.Flags().Bool("force", false, "Duplicate several tasks without confirmation")
+	addSelectionFlags(duplicateCmd)
 }
@@ -1,11 +1,12 @@
 package cli
 
 import (
-	"encoding/csv"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
-	"strconv"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/lucasvidela94/workflow-cli/internal/core"
@@ -13,130 +14,180 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// exporter escribe tareas en un formato de exportación.
+// Cada formato vive en su propio archivo y se agrega al registro con registerExporter.
+type exporter interface {
+	Name() string        // valor de --format
+	Extension() string   // extensión del archivo por defecto, sin punto
+	Description() string // descripción de una línea para la ayuda
+	Write(w io.Writer, job *exportJob) error
+}
+
+// dailyExporter es un exportador que recibe una fila por tarea y día trabajado
+// en lugar de una fila por tarea con su fecha y horas totales
+type dailyExporter interface {
+	exporter
+	byWorkedDay()
+}
+
+// exportFormat guarda el nombre, la extensión y la descripción de un exportador
+type exportFormat struct {
+	name        string
+	extension   string
+	description string
+}
+
+// Name devuelve el valor de --format del exportador
+func (f exportFormat) Name() string {
+	return f.name
+}
+
+// Extension devuelve la extensión del archivo por defecto
+func (f exportFormat) Extension() string {
+	return f.extension
+}
+
+// Description devuelve la descripción del formato para la ayuda
+func (f exportFormat) Description() string {
+	return f.description
+}
+
+// exportJob son las tareas a exportar junto con el almacenamiento y los filtros usados
+type exportJob struct {
+	Store  core.TaskStore
+	Filter core.TaskFilter
+	Tasks  []workflow.Task
+}
+
+// taskDates devuelve la fecha propia de cada tarea exportada, que en los formatos por día
+// se reemplaza por el día trabajado
+func (job *exportJob) taskDates() (map[int]string, error) {
+	records, err := job.Store.GetTasksByIDs(workedTaskIDs(job.Tasks))
+	if err != nil {
+		return nil, fmt.Errorf("could not load tasks: %v", err)
+	}
+
+	dates := make(map[int]string, len(records))
+	for _, task := range records {
+		dates[task.ID] = task.Date
+	}
+	return dates, nil
+}
+
+// exporters son los formatos de exportación registrados según el valor de --format
+var exporters = map[string]exporter{}
+
+// registerExporter agrega un formato de exportación al registro.
+// Se llama desde la inicialización de variables de cada archivo para que la ayuda ya lo incluya.
+func registerExporter(e exporter) bool {
+	if _, exists := exporters[e.Name()]; exists {
+		panic(fmt.Sprintf("exporter %s registered twice", e.Name()))
+	}
+	exporters[e.Name()] = e
+	return true
+}
+
+// exportFormats devuelve los nombres de formato de exportación ordenados
+func exportFormats() []string {
+	formats := make([]string, 0, len(exporters))
+	for format := range exporters {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// exportFormatsHelp lista los formatos registrados con su descripción
+func exportFormatsHelp() string {
+	var help strings.Builder
+	for _, format := range exportFormats() {
+		fmt.Fprintf(&help, "  %-8s %s\n", format, exporters[format].Description())
+	}
+	return help.String()
+}
+
 // exportCmd es el comando para exportar datos
 var exportCmd = &cobra.Command{
 	Use:   "export",
 	Short: "Export tasks to different formats",
-	Long: `Export tasks to different file formats.
-
-Supported formats:
-- CSV: Comma-separated values
-- JSON: JavaScript Object Notation
-
-You can specify date ranges and filters to customize the export.
-
-Examples:
-  workflow export --format csv
-  workflow export --format csv --date 2025-07-21
-  workflow export --format csv --week
-  workflow export --format csv --category tech
-  workflow export --format json --status completed
-`,
 	Run: func(cmd *cobra.Command, args []string) {
 		formatFlag, _ := cmd.Flags().GetString("format")
 		dateFlag, _ := cmd.Flags().GetString("date")
 		weekFlag, _ := cmd.Flags().GetBool("week")
 		monthFlag, _ := cmd.Flags().GetBool("month")
+		fromFlag, _ := cmd.Flags().GetString("from")
+		toFlag, _ := cmd.Flags().GetString("to")
 		categoryFlag, _ := cmd.Flags().GetString("category")
 		statusFlag, _ := cmd.Flags().GetString("status")
+		projectFlag, _ := cmd.Flags().GetString("project")
+		clientFlag, _ := cmd.Flags().GetString("client")
+		tags, anyTags, err := readTagFilters(cmd)
+		if err != nil {
+			printError(err)
+			return
+		}
 		outputFlag, _ := cmd.Flags().GetString("output")
 
 		// Validar formato
-		if formatFlag != "csv" && formatFlag != "json" {
-			printError(fmt.Errorf("unsupported format: %s. Supported formats: csv, json", formatFlag))
+		format, exists := exporters[formatFlag]
+		if !exists {
+			printError(fmt.Errorf("unsupported format: %s. Supported formats: %s", formatFlag, strings.Join(exportFormats(), ", ")))
 			return
 		}
 
-		// Validar que solo se use un flag de período
-		periodFlagsCount := 0
-		if dateFlag != "" {
-			periodFlagsCount++
-		}
-		if weekFlag {
-			periodFlagsCount++
-		}
-		if monthFlag {
-			periodFlagsCount++
+		// Determinar período de exportación
+		exportPeriod, err := resolvePeriod(dateFlag, weekFlag, monthFlag, fromFlag, toFlag)
+		if err != nil {
+			printError(err)
+			return
 		}
 
-		if periodFlagsCount > 1 {
-			printError(fmt.Errorf("only one period flag can be used at a time (--date, --week, --month)"))
-			return
+		// Sin flags de período se exportan todas las tareas
+		if exportPeriod.Kind == periodToday {
+			exportPeriod = period{Kind: periodRange}
 		}
 
-		performExport(formatFlag, dateFlag, weekFlag, monthFlag, categoryFlag, statusFlag, outputFlag)
+		performExport(format, exportPeriod, core.TaskFilter{
+			Category: categoryFlag,
+			Status:   statusFlag,
+			Project:  projectFlag,
+			Client:   clientFlag,
+			Tags:     tags,
+			AnyTags:  anyTags,
+		}, outputFlag)
 	},
 }
 
-// performExport ejecuta la exportación
-func performExport(format, date string, week, month bool, category, status, output string) {
-	taskManager := core.NewTaskManagerSQLite()
+// performExport ejecuta la exportación; con output "-" escribe en la salida estándar
+func performExport(format exporter, exportPeriod period, filter core.TaskFilter, output string) {
+	taskManager := core.NewTaskStore()
 	defer taskManager.Close()
 
-	var tasks []workflow.Task
-	var err error
+	filter.From = exportPeriod.From
+	filter.To = exportPeriod.To
 
-	// Determinar período de exportación
-	if date != "" {
-		// Exportar fecha específica
-		tasks, err = taskManager.GetTasksByDate(date)
-		if err != nil {
-			printError(fmt.Errorf("could not load tasks for date %s: %v", date, err))
-			return
-		}
-	} else if week {
-		// Exportar semana
-		startDate := getWeekStart()
-		endDate := getWeekEnd()
-		tasks, err = taskManager.SearchTasks("", category, status, "")
-		if err != nil {
-			printError(fmt.Errorf("could not load tasks: %v", err))
-			return
-		}
-		// Filtrar por semana
-		var weekTasks []workflow.Task
-		for _, task := range tasks {
-			if task.Date >= startDate && task.Date <= endDate {
-				weekTasks = append(weekTasks, task)
-			}
-		}
-		tasks = weekTasks
-	} else if month {
-		// Exportar mes
-		startDate := getMonthStart()
-		endDate := getMonthEnd()
-		tasks, err = taskManager.SearchTasks("", category, status, "")
-		if err != nil {
-			printError(fmt.Errorf("could not load tasks: %v", err))
-			return
-		}
-		// Filtrar por mes
-		var monthTasks []workflow.Task
-		for _, task := range tasks {
-			if task.Date >= startDate && task.Date <= endDate {
-				monthTasks = append(monthTasks, task)
-			}
-		}
-		tasks = monthTasks
+	// Todos los formatos eligen las tareas por los días trabajados en el período; los formatos
+	// por día reciben las horas de cada día y el resto, las tareas completas
+	var filteredTasks []workflow.Task
+	var err error
+	if _, daily := format.(dailyExporter); daily {
+		filteredTasks, err = searchExportTasks(taskManager, filter)
 	} else {
-		// Exportar todas las tareas
-		tasks, err = taskManager.SearchTasks("", category, status, "")
-		if err != nil {
-			printError(fmt.Errorf("could not load tasks: %v", err))
-			return
-		}
+		filteredTasks, err = loadTaskRecords(taskManager, filter)
+	}
+	if err != nil {
+		printError(err)
+		return
 	}
 
-	// Aplicar filtros adicionales
-	var filteredTasks []workflow.Task
-	for _, task := range tasks {
-		if category != "" && task.Category != category {
-			continue
-		}
-		if status != "" && task.Status != status {
-			continue
+	job := &exportJob{Store: taskManager, Filter: filter, Tasks: filteredTasks}
+
+	// En la salida estándar se escribe el documento aunque esté vacío, para no romper una tubería
+	if output == "-" {
+		if err := format.Write(os.Stdout, job); err != nil {
+			printError(fmt.Errorf("could not export to %s: %v", format.Name(), err))
 		}
-		filteredTasks = append(filteredTasks, task)
+		return
 	}
 
 	if len(filteredTasks) == 0 {
@@ -147,19 +198,11 @@ func performExport(format, date string, week, month bool, category, status, outp
 	// Determinar nombre de archivo
 	if output == "" {
 		timestamp := time.Now().Format("20060102-150405")
-		output = fmt.Sprintf("workflow-export-%s.%s", timestamp, format)
-	}
-
-	// Exportar según formato
-	switch format {
-	case "csv":
-		err = exportToCSV(filteredTasks, output)
-	case "json":
-		err = exportToJSON(filteredTasks, output)
+		output = fmt.Sprintf("workflow-export-%s.%s", timestamp, format.Extension())
 	}
 
-	if err != nil {
-		printError(fmt.Errorf("could not export to %s: %v", format, err))
+	if err := writeExportFile(format, job, output); err != nil {
+		printError(fmt.Errorf("could not export to %s: %v", format.Name(), err))
 		return
 	}
 
@@ -168,84 +211,98 @@ func performExport(format, date string, week, month bool, category, status, outp
 	printSuccess(fmt.Sprintf("Exported %d tasks to %s", len(filteredTasks), absPath))
 }
 
-// exportToCSV exporta las tareas a formato CSV
-func exportToCSV(tasks []workflow.Task, filename string) error {
+// writeExportFile crea el archivo de exportación y escribe las tareas en él
+func writeExportFile(format exporter, job *exportJob, filename string) error {
 	file, err := os.Create(filename)
 	if err != nil {
 		return err
 	}
 	defer file.Close()
 
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
-
-	// Escribir encabezados
-	headers := []string{"ID", "Description", "Hours", "Category", "Date", "Status", "Created At"}
-	if err := writer.Write(headers); err != nil {
+	if err := format.Write(file, job); err != nil {
 		return err
 	}
+	return file.Close()
+}
 
-	// Escribir datos
-	for _, task := range tasks {
-		row := []string{
-			strconv.Itoa(task.ID),
-			task.Description,
-			strconv.FormatFloat(task.Hours, 'f', 1, 64),
-			task.Category,
-			task.Date,
-			task.Status,
-			task.CreatedAt.Format("2006-01-02 15:04:05"),
-		}
-		if err := writer.Write(row); err != nil {
-			return err
-		}
-	}
+// loadExportTasks devuelve las horas trabajadas por día de las tareas del período que cumplen el filtro
+func loadExportTasks(exportPeriod period, filter core.TaskFilter) ([]workflow.Task, error) {
+	taskManager := core.NewTaskStore()
+	defer taskManager.Close()
 
-	return nil
+	filter.From = exportPeriod.From
+	filter.To = exportPeriod.To
+	return searchExportTasks(taskManager, filter)
 }
 
-// exportToJSON exporta las tareas a formato JSON
-func exportToJSON(tasks []workflow.Task, filename string) error {
-	file, err := os.Create(filename)
+// searchExportTasks busca las horas trabajadas por día que cumplen el filtro
+func searchExportTasks(taskManager core.TaskStore, filter core.TaskFilter) ([]workflow.Task, error) {
+	tasks, err := taskManager.SearchTasksInRange(filter)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("could not load tasks: %v", err)
 	}
-	defer file.Close()
+	return tasks, nil
+}
 
-	// Escribir inicio del JSON
-	file.WriteString("[\n")
-
-	for i, task := range tasks {
-		// Escribir tarea
-		file.WriteString("  {\n")
-		file.WriteString(fmt.Sprintf("    \"id\": %d,\n", task.ID))
-		file.WriteString(fmt.Sprintf("    \"description\": \"%s\",\n", task.Description))
-		file.WriteString(fmt.Sprintf("    \"hours\": %.1f,\n", task.Hours))
-		file.WriteString(fmt.Sprintf("    \"category\": \"%s\",\n", task.Category))
-		file.WriteString(fmt.Sprintf("    \"date\": \"%s\",\n", task.Date))
-		file.WriteString(fmt.Sprintf("    \"status\": \"%s\",\n", task.Status))
-		file.WriteString(fmt.Sprintf("    \"created_at\": \"%s\"\n", task.CreatedAt.Format("2006-01-02 15:04:05")))
-
-		if i < len(tasks)-1 {
-			file.WriteString("  },\n")
-		} else {
-			file.WriteString("  }\n")
-		}
+// loadTaskRecords devuelve las tareas completas trabajadas en el período que cumplen el filtro, ordenadas por ID.
+// Usa los mismos días trabajados que el CSV y los reportes, pero cada tarea aparece una vez
+// con su fecha y horas totales, incluidas las horas registradas fuera del período.
+func loadTaskRecords(taskManager core.TaskStore, filter core.TaskFilter) ([]workflow.Task, error) {
+	tasks, err := searchWorkedTasks(taskManager, filter)
+	if err != nil {
+		return nil, fmt.Errorf("could not load tasks: %v", err)
 	}
+	return tasks, nil
+}
 
-	// Escribir fin del JSON
-	file.WriteString("]\n")
+// timeEntryStore es un almacenamiento que guarda los registros de horas de cada tarea
+type timeEntryStore interface {
+	GetTimeEntries(taskID int) ([]workflow.TimeEntry, error)
+}
 
-	return nil
+// taskTimeEntries devuelve los registros de horas de una tarea si el almacenamiento los guarda
+func taskTimeEntries(taskManager core.TaskStore, taskID int) ([]workflow.TimeEntry, error) {
+	entries, ok := taskManager.(timeEntryStore)
+	if !ok {
+		return nil, nil
+	}
+	return entries.GetTimeEntries(taskID)
 }
 
 func init() {
+	exportCmd.Long = `Export tasks to different file formats.
+
+Supported formats:
+` + exportFormatsHelp() + `
+The csv, json and ics formats can be read back with 'workflow import'.
+With --output - the export is written to standard output so it can be piped.
+
+You can specify date ranges and filters to customize the export.
+
+Examples:
+  workflow export --format csv
+  workflow export --format csv --date 2025-07-21
+  workflow export --format csv --week
+  workflow export --format csv --from 2024-01-01 --to 2024-12-31
+  workflow export --format csv --category tech
+  workflow export --format json --status completed
+  workflow export --format ics --week
+  workflow export --format ndjson --output - | jq .description
+  workflow export --format md --month --client "Acme Corp"
+  workflow export --format csv --any-tag billable --any-tag overtime
+`
+
 	// Flags para export
-	exportCmd.Flags().String("format", "csv", "Export format (csv, json)")
-	exportCmd.Flags().String("date", "", "Export tasks for specific date (format: YYYY-MM-DD)")
+	exportCmd.Flags().String("format", "csv", "Export format ("+strings.Join(exportFormats(), ", ")+")")
+	exportCmd.Flags().String("date", "", "Export tasks for specific date or week ("+dateRangeFlagHelp+")")
 	exportCmd.Flags().Bool("week", false, "Export weekly tasks")
 	exportCmd.Flags().Bool("month", false, "Export monthly tasks")
+	exportCmd.Flags().String("from", "", "Start of custom date range, inclusive ("+dateRangeFlagHelp+")")
+	exportCmd.Flags().String("to", "", "End of custom date range, inclusive ("+dateRangeFlagHelp+")")
 	exportCmd.Flags().String("category", "", "Filter by category")
+	exportCmd.Flags().String("project", "", "Filter by project")
+	exportCmd.Flags().String("client", "", "Filter by client")
+	addTagFilterFlags(exportCmd)
 	exportCmd.Flags().String("status", "", "Filter by status (pending, in_progress, completed, paused)")
-	exportCmd.Flags().String("output", "", "Output filename (default: workflow-export-YYYYMMDD-HHMMSS.format)")
+	exportCmd.Flags().String("output", "", "Output filename, or - for standard output (default: workflow-export-YYYYMMDD-HHMMSS.format)")
 }
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// csvExporter escribe una fila por tarea y día trabajado.
+// Las horas van con precisión completa y created_at en UTC para que 'workflow import' lea el archivo sin cambios.
+type csvExporter struct {
+	exportFormat
+}
+
+var _ = registerExporter(csvExporter{exportFormat{
+	name:        "csv",
+	extension:   "csv",
+	description: "comma-separated values, one row per task and worked day",
+}})
+
+// byWorkedDay indica que el CSV recibe las horas trabajadas de cada día
+func (csvExporter) byWorkedDay() {}
+
+// Write escribe las tareas en formato CSV.
+// Date es el día trabajado de la fila y Task Date, la fecha propia de la tarea.
+func (csvExporter) Write(w io.Writer, job *exportJob) error {
+	taskDates, err := job.taskDates()
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+
+	// Escribir encabezados
+	headers := []string{"ID", "Description", "Hours", "Category", "Date", "Status", "Created At", "Project", "Client", "Tags", "Task Date"}
+	if err := writer.Write(headers); err != nil {
+		return err
+	}
+
+	// Escribir datos
+	for _, task := range job.Tasks {
+		row := []string{
+			strconv.Itoa(task.ID),
+			task.Description,
+			strconv.FormatFloat(task.Hours, 'f', -1, 64),
+			task.Category,
+			task.Date,
+			task.Status,
+			task.CreatedAt.UTC().Format("2006-01-02 15:04:05"),
+			task.Project,
+			task.Client,
+			strings.Join(task.Tags, ";"),
+			taskDates[task.ID],
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
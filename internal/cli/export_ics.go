@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"io"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+)
+
+// icsExporter escribe cada tarea como un evento de calendario
+type icsExporter struct {
+	exportFormat
+}
+
+var _ = registerExporter(icsExporter{exportFormat{
+	name:        "ics",
+	extension:   "ics",
+	description: "one calendar event per task, lasting its hours",
+}})
+
+// Write escribe el calendario con un evento por tarea
+func (icsExporter) Write(w io.Writer, job *exportJob) error {
+	events := make([]core.ICalEvent, 0, len(job.Tasks))
+	for _, task := range job.Tasks {
+		entries, err := taskTimeEntries(job.Store, task.ID)
+		if err != nil {
+			return err
+		}
+		events = append(events, core.TaskICalEvent(task, entries))
+	}
+	return core.WriteICal(w, events, time.Now())
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"io"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+)
+
+// jsonExporter escribe las tareas con sus registros de horas en el formato de exportación versionado
+type jsonExporter struct {
+	exportFormat
+}
+
+var _ = registerExporter(jsonExporter{exportFormat{
+	name:        "json",
+	extension:   "json",
+	description: "every field and time entry of each task in a versioned document",
+}})
+
+// Write escribe el documento JSON tarea por tarea
+func (jsonExporter) Write(w io.Writer, job *exportJob) error {
+	writer, err := core.NewJSONExportWriter(w, job.Filter, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, task := range job.Tasks {
+		exported := core.ExportedTask{Task: task}
+		if exported.TimeEntries, err = taskTimeEntries(job.Store, task.ID); err != nil {
+			return err
+		}
+		if err := writer.WriteTask(exported); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// markdownExporter escribe las tareas como una tabla Markdown, con una fila por tarea y día trabajado
+// para que el total sume solo las horas del período
+type markdownExporter struct {
+	exportFormat
+}
+
+var _ = registerExporter(markdownExporter{exportFormat{
+	name:        "md",
+	extension:   "md",
+	description: "Markdown table, one row per task and worked day, with a total",
+}})
+
+// byWorkedDay indica que la tabla recibe las horas trabajadas de cada día
+func (markdownExporter) byWorkedDay() {}
+
+// Write escribe la tabla de tareas
+func (markdownExporter) Write(w io.Writer, job *exportJob) error {
+	fmt.Fprintf(w, "| ID | Date | Task | Category | Project | Client | Tags | Hours | Status |\n")
+	fmt.Fprintf(w, "| ---: | --- | --- | --- | --- | --- | --- | ---: | --- |\n")
+
+	total := 0.0
+	for _, task := range job.Tasks {
+		fmt.Fprintf(w, "| %d | %s | %s | %s | %s | %s | %s | %.2f | %s |\n",
+			task.ID, task.Date, markdownEscape(task.Description), markdownEscape(task.Category),
+			markdownEscape(task.Project), markdownEscape(task.Client), markdownEscape(strings.Join(task.Tags, ", ")),
+			task.Hours, task.Status)
+		total += task.Hours
+	}
+
+	_, err := fmt.Fprintf(w, "\n**Total: %.2fh in %d tasks**\n", total, len(workedTaskIDs(job.Tasks)))
+	return err
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+)
+
+// ndjsonExporter escribe una tarea JSON por línea, cómoda para procesar con jq o en streaming
+type ndjsonExporter struct {
+	exportFormat
+}
+
+var _ = registerExporter(ndjsonExporter{exportFormat{
+	name:        "ndjson",
+	extension:   "ndjson",
+	description: "one JSON task with its time entries per line (newline-delimited JSON)",
+}})
+
+// Write escribe cada tarea en una línea
+func (ndjsonExporter) Write(w io.Writer, job *exportJob) error {
+	encoder := json.NewEncoder(w)
+	for _, task := range job.Tasks {
+		exported := core.ExportedTask{Task: task}
+		var err error
+		if exported.TimeEntries, err = taskTimeEntries(job.Store, task.ID); err != nil {
+			return err
+		}
+		if err := encoder.Encode(exported); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// entryStore es un almacenamiento de prueba que solo devuelve tareas y registros de horas
+type entryStore struct {
+	core.TaskStore
+	tasks   []workflow.Task
+	entries map[int][]workflow.TimeEntry
+}
+
+// GetTasksByIDs devuelve las tareas del almacenamiento con los IDs indicados
+func (s entryStore) GetTasksByIDs(ids []int) ([]workflow.Task, error) {
+	var found []workflow.Task
+	for _, task := range s.tasks {
+		for _, id := range ids {
+			if task.ID == id {
+				found = append(found, task)
+			}
+		}
+	}
+	return found, nil
+}
+
+// GetTimeEntries devuelve los registros de horas de una tarea
+func (s entryStore) GetTimeEntries(taskID int) ([]workflow.TimeEntry, error) {
+	return s.entries[taskID], nil
+}
+
+// acceptCategory acepta cualquier categoría al importar
+func acceptCategory(string) error {
+	return nil
+}
+
+// TestExportImportRoundTrip comprueba que 'workflow import' lea sin cambios lo que escriben los formatos csv y json
+func TestExportImportRoundTrip(t *testing.T) {
+	billable := false
+	createdAt := time.Date(2025, time.July, 20, 22, 30, 15, 123456789, time.UTC)
+	tasks := []workflow.Task{
+		{ID: 7, Description: "API, \"v2\"", Hours: 1.75, Category: "tech", Date: "2025-07-22", Status: workflow.StatusCompleted,
+			CreatedAt: createdAt, Project: "Web", Client: "Acme", Tags: []string{"backend", "urgent"}},
+		{ID: 8, Description: "Standup", Hours: 0.25, Category: "meeting", Date: "2025-07-22", Status: workflow.StatusPending,
+			CreatedAt: createdAt.Add(time.Hour), Billable: &billable},
+	}
+	entries := map[int][]workflow.TimeEntry{
+		7: {
+			{ID: 1, TaskID: 7, Date: "2025-07-21", Hours: 0.25, Start: createdAt, End: createdAt.Add(15 * time.Minute)},
+			{ID: 2, TaskID: 7, Date: "2025-07-22", Hours: 1.5, Note: "pairing"},
+		},
+		8: {{ID: 3, TaskID: 8, Date: "2025-07-22", Hours: 0.25}},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var buffer bytes.Buffer
+		job := &exportJob{Store: entryStore{tasks: tasks, entries: entries}, Tasks: tasks}
+		if err := (jsonExporter{}).Write(&buffer, job); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+
+		imported, err := core.ReadJSONExport(&buffer)
+		if err != nil {
+			t.Fatalf("ReadJSONExport error: %v", err)
+		}
+
+		want := []core.ExportedTask{{Task: tasks[0], TimeEntries: entries[7]}, {Task: tasks[1], TimeEntries: entries[8]}}
+		if !reflect.DeepEqual(imported, want) {
+			t.Errorf("imported =\n%+v\nwant\n%+v", imported, want)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		// El CSV recibe una fila por tarea y día trabajado; la tarea 7 se registró antes de su fecha
+		var rows []workflow.Task
+		for _, task := range tasks {
+			for _, entry := range entries[task.ID] {
+				row := task
+				row.Date = entry.Date
+				row.Hours = entry.Hours
+				rows = append(rows, row)
+			}
+		}
+
+		var buffer bytes.Buffer
+		if err := (csvExporter{}).Write(&buffer, &exportJob{Store: entryStore{tasks: tasks}, Tasks: rows}); err != nil {
+			t.Fatalf("Write error: %v", err)
+		}
+
+		mapping, err := core.CSVMappingFor(core.ImportFormatCSV)
+		if err != nil {
+			t.Fatalf("CSVMappingFor error: %v", err)
+		}
+		imported, err := core.ReadCSVImport(&buffer, mapping, "tech", acceptCategory)
+		if err != nil {
+			t.Fatalf("ReadCSVImport error: %v", err)
+		}
+		if len(imported) != len(tasks) {
+			t.Fatalf("imported %d tasks, want %d", len(imported), len(tasks))
+		}
+
+		for i, task := range tasks {
+			got := imported[i]
+			if core.DuplicateKey(got.Task) != core.DuplicateKey(task) {
+				t.Errorf("task %d: duplicate key %q, want %q", task.ID, core.DuplicateKey(got.Task), core.DuplicateKey(task))
+			}
+			// El CSV no guarda el ID, la facturación ni las fracciones de segundo
+			want := task
+			want.ID = 0
+			want.Billable = nil
+			want.CreatedAt = task.CreatedAt.Truncate(time.Second)
+			if !reflect.DeepEqual(got.Task, want) {
+				t.Errorf("task %d =\n%+v\nwant\n%+v", task.ID, got.Task, want)
+			}
+
+			for j, entry := range entries[task.ID] {
+				if j >= len(got.TimeEntries) || got.TimeEntries[j].Date != entry.Date || got.TimeEntries[j].Hours != entry.Hours {
+					t.Errorf("task %d: time entries %+v, want dates and hours of %+v", task.ID, got.TimeEntries, entries[task.ID])
+					break
+				}
+			}
+		}
+	})
+}
+
+// TestMarkdownExportTotal comprueba que el total de la tabla sume las horas de cada día y cuente cada tarea una vez
+func TestMarkdownExportTotal(t *testing.T) {
+	rows := []workflow.Task{
+		{ID: 7, Description: "API | v2", Hours: 0.25, Category: "tech", Date: "2025-07-21", Status: workflow.StatusCompleted},
+		{ID: 7, Description: "API | v2", Hours: 1.5, Category: "tech", Date: "2025-07-22", Status: workflow.StatusCompleted},
+		{ID: 8, Description: "Standup", Hours: 0.25, Category: "meeting", Date: "2025-07-22", Status: workflow.StatusPending},
+	}
+
+	var buffer bytes.Buffer
+	if err := (markdownExporter{}).Write(&buffer, &exportJob{Tasks: rows}); err != nil {
+		t.Fatalf("Write error: %v", err)
+	}
+
+	output := buffer.String()
+	if !strings.Contains(output, "| 7 | 2025-07-22 | API \\| v2 | tech |") {
+		t.Errorf("missing the escaped row of the second worked day:\n%s", output)
+	}
+	if !strings.Contains(output, "**Total: 2.00h in 2 tasks**") {
+		t.Errorf("wrong total:\n%s", output)
+	}
+}
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// historyCmd muestra el historial de cambios de una tarea
+var historyCmd = &cobra.Command{
+	Use:   "history <id>",
+	Short: "Show the change history of a task",
+	Long: `Show every recorded change of a task with its old and new values.
+
+Examples:
+  workflow history 12
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			printError(fmt.Errorf("invalid task ID: %s", args[0]))
+			return
+		}
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		history, err := taskManager.GetTaskHistory(id)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		fmt.Printf("📜 History for task %d:\n", id)
+		if len(history) == 0 {
+			fmt.Println("  No recorded changes.")
+			return
+		}
+
+		for _, entry := range history {
+			undone := ""
+			if entry.Undone {
+				undone = " (undone)"
+			}
+			fmt.Printf("  #%d %s %s%s\n", entry.ID, entry.CreatedAt.In(core.Location()).Format("2006-01-02 15:04"), entry.Operation, undone)
+			for _, change := range describeChanges(entry.Before, entry.After) {
+				fmt.Printf("      %s\n", change)
+			}
+		}
+	},
+}
+
+// undoCmd deshace las últimas operaciones sobre tareas
+var undoCmd = &cobra.Command{
+	Use:   "undo [n]",
+	Short: "Undo the last task changes",
+	Long: `Undo the last n task changes (default 1): additions, edits, status changes,
+logged hours and deletions. Undone changes stay in the history.
+
+Examples:
+  workflow undo
+  workflow undo 3
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		n := 1
+		if len(args) > 0 {
+			var err error
+			if n, err = strconv.Atoi(args[0]); err != nil {
+				printError(fmt.Errorf("invalid number of operations: %s", args[0]))
+				return
+			}
+		}
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		reverted, err := taskManager.Undo(n)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		if len(reverted) == 0 {
+			printInfo("Nothing to undo")
+			return
+		}
+
+		for _, entry := range reverted {
+			fmt.Printf("  ↩️  %s of task %d (%s)\n", entry.Operation, entry.TaskID, describeSnapshot(entry))
+		}
+		printSuccess(fmt.Sprintf("Undid %d operation(s)", len(reverted)))
+	},
+}
+
+// describeSnapshot devuelve la descripción de la tarea afectada por una entrada del historial
+func describeSnapshot(entry workflow.HistoryEntry) string {
+	if entry.After != nil {
+		return entry.After.Description
+	}
+	if entry.Before != nil {
+		return entry.Before.Description
+	}
+	return "unknown task"
+}
+
+// describeChanges enumera los campos que cambiaron entre dos estados de una tarea
+func describeChanges(before *workflow.Task, after *workflow.Task) []string {
+	switch {
+	case before == nil && after == nil:
+		return nil
+	case before == nil:
+		return []string{fmt.Sprintf("created: %s (%.2fh, %s, %s, %s)", after.Description, after.Hours, after.Category, after.Date, after.Status)}
+	case after == nil:
+		return []string{fmt.Sprintf("deleted: %s (%.2fh, %s, %s, %s)", before.Description, before.Hours, before.Category, before.Date, before.Status)}
+	}
+
+	var changes []string
+	addChange := func(field string, old string, new string) {
+		if old != new {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", field, displayValue(old), displayValue(new)))
+		}
+	}
+
+	addChange("description", before.Description, after.Description)
+	addChange("hours", fmt.Sprintf("%.2fh", before.Hours), fmt.Sprintf("%.2fh", after.Hours))
+	addChange("category", before.Category, after.Category)
+	addChange("date", before.Date, after.Date)
+	addChange("status", before.Status, after.Status)
+	addChange("project", before.Project, after.Project)
+	addChange("tags", strings.Join(before.Tags, ", "), strings.Join(after.Tags, ", "))
+	addChange("in trash", strconv.FormatBool(before.InTrash()), strconv.FormatBool(after.InTrash()))
+
+	return changes
+}
+
+// displayValue muestra un guion para los valores vacíos
+func displayValue(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
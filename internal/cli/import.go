@@ -0,0 +1,365 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// importFormats son los formatos aceptados por 'workflow import'
+var importFormats = []string{core.ImportFormatCSV, core.ImportFormatJSON, core.ImportFormatToggl, core.ImportFormatClockify, core.ImportFormatICal}
+
+// importCmd es el comando para importar tareas desde un archivo
+var importCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Import tasks from CSV/JSON/ICS or other time trackers",
+	Long: `Import tasks from a file into the task store in a single transaction:
+either every task is imported or none is.
+
+Supported formats:
+- csv: files written by 'workflow export --format csv'; rows with the same ID
+  become one task with an entry per worked day, dated by its Task Date column
+- json: files written by 'workflow export --format json', with their time entries
+- toggl-csv: Toggl Track detailed report (Duration as HH:MM:SS)
+- clockify-csv: Clockify detailed report (Duration (decimal) or Duration (h))
+- ics: calendar events (iCalendar) become completed tasks of the 'meeting'
+  category lasting from DTSTART to DTEND; all-day events are skipped and
+  recurring events only import their first occurrence. Times with an unknown
+  TZID (such as Windows zone names) are read in the configured timezone
+
+The format is guessed from the file extension when --format is not given.
+Columns of any CSV can be remapped with --map field=Column, where field is
+one of: ` + strings.Join(core.ImportFields(), ", ") + `.
+
+--from and --to only import tasks dated within that range.
+
+A task is a duplicate when another task, already stored or earlier in the file,
+has the same date, description and hours. Duplicates are skipped unless
+--allow-duplicates is given. Projects that do not exist are created.
+
+Examples:
+  workflow import workflow-export-20250721-180000.json
+  workflow import tasks.csv --dry-run
+  workflow import toggl.csv --format toggl-csv --category development
+  workflow import clockify.csv --format clockify-csv --date-format DD/MM/YYYY
+  workflow import calendar.ics --from 2025-07-01 --dry-run
+  workflow import hours.csv --map description=Task --map hours=Time --map date=Day
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		formatFlag, _ := cmd.Flags().GetString("format")
+		mapFlags, _ := cmd.Flags().GetStringArray("map")
+		dateFormatFlag, _ := cmd.Flags().GetString("date-format")
+		categoryFlag, _ := cmd.Flags().GetString("category")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		allowDuplicates, _ := cmd.Flags().GetBool("allow-duplicates")
+		fromFlag, _ := cmd.Flags().GetString("from")
+		toFlag, _ := cmd.Flags().GetString("to")
+
+		importPeriod, err := resolvePeriod("", false, false, fromFlag, toFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		filename := args[0]
+		if formatFlag == "" {
+			switch strings.ToLower(filepath.Ext(filename)) {
+			case ".json":
+				formatFlag = core.ImportFormatJSON
+			case ".ics":
+				formatFlag = core.ImportFormatICal
+			default:
+				formatFlag = core.ImportFormatCSV
+			}
+		}
+
+		// Los eventos de calendario son reuniones salvo que se indique otra categoría
+		if formatFlag == core.ImportFormatICal && !cmd.Flags().Changed("category") {
+			categoryFlag = "meeting"
+		}
+
+		taskManager := core.NewTaskStore()
+		defer taskManager.Close()
+
+		if err := taskManager.ValidateCategory(categoryFlag); err != nil {
+			printError(err)
+			return
+		}
+
+		tasks, err := readImportFile(taskManager, filename, formatFlag, mapFlags, dateFormatFlag, categoryFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
+		if importPeriod.Kind == periodRange {
+			tasks = tasksInPeriod(tasks, importPeriod)
+		}
+		if len(tasks) == 0 {
+			printInfo("No tasks found in the file.")
+			return
+		}
+
+		tasks, duplicates, err := splitDuplicates(taskManager, tasks, allowDuplicates)
+		if err != nil {
+			printError(err)
+			return
+		}
+		newProjects := importNewProjects(taskManager, tasks)
+
+		if dryRun {
+			printImportPreview(tasks, duplicates, newProjects)
+			return
+		}
+
+		if len(tasks) == 0 {
+			printInfo(fmt.Sprintf("Nothing to import: all %d tasks are already stored (use --allow-duplicates to import them anyway).", len(duplicates)))
+			return
+		}
+
+		if err := taskManager.ImportTasks(tasks); err != nil {
+			printError(fmt.Errorf("could not import tasks: %v", err))
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Imported %d tasks (%.1fh) from %s", len(tasks), importHours(tasks), filename))
+		if len(duplicates) > 0 {
+			printInfo(fmt.Sprintf("Skipped %d duplicate tasks", len(duplicates)))
+		}
+		if len(newProjects) > 0 {
+			printInfo(fmt.Sprintf("Created projects: %s", strings.Join(newProjects, ", ")))
+		}
+	},
+}
+
+// warnUnknownTimezones avisa de los eventos cuya zona horaria no se reconoció y se leyeron en la zona configurada
+func warnUnknownTimezones(events []core.ICalEvent) {
+	var timezones []string
+	count := 0
+	for _, event := range events {
+		if event.UnknownTZID == "" {
+			continue
+		}
+		count++
+		if !slices.Contains(timezones, event.UnknownTZID) {
+			timezones = append(timezones, event.UnknownTZID)
+		}
+	}
+
+	if count > 0 {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: Unknown timezone %s in %d calendar event(s); their times were read in the configured timezone (%s)\n",
+			strings.Join(timezones, ", "), count, core.Location())
+	}
+}
+
+// readImportFile lee las tareas de un archivo en el formato indicado
+func readImportFile(taskManager core.TaskStore, filename string, format string, mapFlags []string, dateFormat string, category string) ([]core.ExportedTask, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %s: %v", filename, err)
+	}
+	defer file.Close()
+
+	if (format == core.ImportFormatJSON || format == core.ImportFormatICal) && (len(mapFlags) > 0 || dateFormat != "") {
+		return nil, fmt.Errorf("--map and --date-format only apply to CSV formats")
+	}
+
+	if format == core.ImportFormatICal {
+		events, err := core.ParseICal(file)
+		if err != nil {
+			return nil, err
+		}
+		tasks, skipped := core.ICalImportTasks(events, category)
+		if skipped > 0 {
+			printInfo(fmt.Sprintf("Skipped %d calendar events without a duration (all-day or without DTEND)", skipped))
+		}
+		warnUnknownTimezones(events)
+		return tasks, nil
+	}
+
+	if format == core.ImportFormatJSON {
+		tasks, err := core.ReadJSONExport(file)
+		if err != nil {
+			return nil, err
+		}
+		for i := range tasks {
+			if tasks[i].Category == "" {
+				tasks[i].Category = category
+			}
+			if tasks[i].Status == "" {
+				tasks[i].Status = workflow.StatusCompleted
+			}
+			if err := taskManager.ValidateCategory(tasks[i].Category); err != nil {
+				return nil, fmt.Errorf("task %d: %v", i+1, err)
+			}
+		}
+		return tasks, nil
+	}
+
+	mapping, err := core.CSVMappingFor(format)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported format: %s. Supported formats: %s", format, strings.Join(importFormats, ", "))
+	}
+
+	for _, flag := range mapFlags {
+		field, column, found := strings.Cut(flag, "=")
+		field = strings.ToLower(strings.TrimSpace(field))
+		if !found || strings.TrimSpace(column) == "" || !isImportField(field) {
+			return nil, fmt.Errorf("invalid --map '%s' (use field=Column with field one of: %s)", flag, strings.Join(core.ImportFields(), ", "))
+		}
+		mapping.Columns[field] = strings.TrimSpace(column)
+	}
+
+	if dateFormat != "" {
+		layout, err := core.ParseDateLayout(dateFormat)
+		if err != nil {
+			return nil, err
+		}
+		mapping.DateLayouts = []string{layout}
+	}
+
+	return core.ReadCSVImport(file, mapping, category, taskManager.ValidateCategory)
+}
+
+// tasksInPeriod devuelve las tareas importadas con fecha dentro del período
+func tasksInPeriod(tasks []core.ExportedTask, importPeriod period) []core.ExportedTask {
+	var inPeriod []core.ExportedTask
+	for _, task := range tasks {
+		if (importPeriod.From == "" || task.Date >= importPeriod.From) && (importPeriod.To == "" || task.Date <= importPeriod.To) {
+			inPeriod = append(inPeriod, task)
+		}
+	}
+	return inPeriod
+}
+
+// isImportField indica si un nombre es un campo que se puede asociar con --map
+func isImportField(field string) bool {
+	for _, name := range core.ImportFields() {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
+
+// splitDuplicates separa las tareas que ya existen o se repiten en el archivo.
+// Con allowDuplicates se importan todas y no se informa ningún duplicado.
+func splitDuplicates(taskManager core.TaskStore, tasks []core.ExportedTask, allowDuplicates bool) ([]core.ExportedTask, []core.ExportedTask, error) {
+	if allowDuplicates {
+		return tasks, nil, nil
+	}
+
+	existing, err := taskManager.LoadTasks()
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not load tasks: %v", err)
+	}
+
+	seen := make(map[string]bool, len(existing))
+	for _, task := range existing {
+		if !task.InTrash() {
+			seen[core.DuplicateKey(task)] = true
+		}
+	}
+
+	var unique, duplicates []core.ExportedTask
+	for _, task := range tasks {
+		key := core.DuplicateKey(task.Task)
+		if seen[key] {
+			duplicates = append(duplicates, task)
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, task)
+	}
+	return unique, duplicates, nil
+}
+
+// projectStore es un almacenamiento que guarda proyectos y clientes
+type projectStore interface {
+	ListProjects(includeArchived bool) ([]workflow.Project, error)
+}
+
+// importNewProjects devuelve los proyectos de las tareas que todavía no existen
+func importNewProjects(taskManager core.TaskStore, tasks []core.ExportedTask) []string {
+	projects, ok := taskManager.(projectStore)
+	if !ok {
+		return nil
+	}
+
+	existing, err := projects.ListProjects(true)
+	if err != nil {
+		return nil
+	}
+	known := make(map[string]bool, len(existing))
+	for _, project := range existing {
+		known[project.Name] = true
+	}
+
+	var created []string
+	for _, task := range tasks {
+		if task.Project != "" && !known[task.Project] {
+			known[task.Project] = true
+			created = append(created, task.Project)
+		}
+	}
+	return created
+}
+
+// printImportPreview muestra lo que haría la importación sin guardar nada
+func printImportPreview(tasks []core.ExportedTask, duplicates []core.ExportedTask, newProjects []string) {
+	printInfo("Dry run: nothing was imported")
+
+	fmt.Printf("\n📋 Tasks to import (%d, %.1fh):\n", len(tasks), importHours(tasks))
+	for _, task := range tasks {
+		fmt.Printf("  %s\n", formatImportTask(task))
+	}
+
+	if len(duplicates) > 0 {
+		fmt.Printf("\n⏭️  Duplicates skipped (%d):\n", len(duplicates))
+		for _, task := range duplicates {
+			fmt.Printf("  %s\n", formatImportTask(task))
+		}
+	}
+
+	if len(newProjects) > 0 {
+		fmt.Printf("\n📁 Projects to create: %s\n", strings.Join(newProjects, ", "))
+	}
+}
+
+// formatImportTask muestra una tarea importada en una línea
+func formatImportTask(task core.ExportedTask) string {
+	line := fmt.Sprintf("%s %s %s (%.2fh, %s, %s)", task.Date, workflow.GetIcon(task.Category), task.Description, task.Hours, task.Category, task.Status)
+	if task.Project != "" {
+		line += " [" + task.Project + "]"
+	}
+	if len(task.TimeEntries) > 1 {
+		line += fmt.Sprintf(" - %d days", len(task.TimeEntries))
+	}
+	return line
+}
+
+// importHours suma las horas de las tareas importadas
+func importHours(tasks []core.ExportedTask) float64 {
+	total := 0.0
+	for _, task := range tasks {
+		total += task.Hours
+	}
+	return total
+}
+
+func init() {
+	importCmd.Flags().String("format", "", "Import format ("+strings.Join(importFormats, ", ")+"; default: from the file extension)")
+	importCmd.Flags().StringArray("map", nil, "Read a field from another CSV column, as field=Column (repeatable)")
+	importCmd.Flags().String("date-format", "", "Date format of CSV dates, e.g. DD/MM/YYYY (default: YYYY-MM-DD, MM/DD/YYYY, DD.MM.YYYY)")
+	importCmd.Flags().String("category", workflow.DefaultCategory, "Category for rows without one (ics: category of every event, default meeting)")
+	importCmd.Flags().String("from", "", "Only import tasks dated on or after this date ("+dateRangeFlagHelp+")")
+	importCmd.Flags().String("to", "", "Only import tasks dated on or before this date ("+dateRangeFlagHelp+")")
+	importCmd.Flags().Bool("dry-run", false, "Show what would be imported without saving anything")
+	importCmd.Flags().Bool("allow-duplicates", false, "Import tasks that match an existing task's date, description and hours")
+}
@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+// invoiceCmd genera un resumen valorizado de las horas facturables
+var invoiceCmd = &cobra.Command{
+	Use:   "invoice",
+	Short: "Price billable hours for a client and period",
+	Long: `Produce an invoice-ready summary of billable hours, grouped by project and
+category and priced with the rates set with 'workflow rate'. Tasks are billable
+according to their category unless marked with 'add --billable/--no-billable'.
+
+Hours of each task are rounded with the configured billing rounding; --round and
+--round-mode override it for one invoice.
+
+Formats:
+- text: printed to the terminal (default)
+- csv: one row per line item plus a total row
+- json: the invoice with its line items
+
+Examples:
+  workflow invoice --client "Acme Corp" --month 2025-07
+  workflow invoice --client "Acme Corp" --month 2025-07 --format csv
+  workflow invoice --project "Website redesign" --from 2025-07-01 --to 2025-07-15
+  workflow invoice --client "Acme Corp" --round 15 --round-mode up --format json --output acme-july.json
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		formatFlag, _ := cmd.Flags().GetString("format")
+		monthFlag, _ := cmd.Flags().GetString("month")
+		fromFlag, _ := cmd.Flags().GetString("from")
+		toFlag, _ := cmd.Flags().GetString("to")
+		clientFlag, _ := cmd.Flags().GetString("client")
+		projectFlag, _ := cmd.Flags().GetString("project")
+		categoryFlag, _ := cmd.Flags().GetString("category")
+		outputFlag, _ := cmd.Flags().GetString("output")
+		tags, anyTags, err := readTagFilters(cmd)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		if formatFlag != "text" && formatFlag != "csv" && formatFlag != "json" {
+			printError(fmt.Errorf("unsupported format: %s. Supported formats: text, csv, json", formatFlag))
+			return
+		}
+
+		// Un rango explícito reemplaza al mes
+		var invoicePeriod period
+		if fromFlag != "" || toFlag != "" {
+			if monthFlag != "" {
+				printError(fmt.Errorf("use either --month or --from/--to"))
+				return
+			}
+			if invoicePeriod, err = resolvePeriod("", false, false, fromFlag, toFlag); err != nil {
+				printError(err)
+				return
+			}
+		} else if invoicePeriod, err = parseMonthFlag(monthFlag); err != nil {
+			printError(err)
+			return
+		}
+
+		configManager := core.LoadConfigManager()
+		billing := configManager.GetBilling()
+		if cmd.Flags().Changed("round") {
+			billing.Rounding, _ = cmd.Flags().GetInt("round")
+		}
+		if cmd.Flags().Changed("round-mode") {
+			billing.RoundingMode, _ = cmd.Flags().GetString("round-mode")
+		}
+		if err := core.ValidateRounding(billing.Rounding, billing.RoundingMode); err != nil {
+			printError(err)
+			return
+		}
+
+		tasks, err := loadExportTasks(invoicePeriod, core.TaskFilter{
+			Category: categoryFlag,
+			Project:  projectFlag,
+			Client:   clientFlag,
+			Tags:     tags,
+			AnyTags:  anyTags,
+		})
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		invoice := core.NewInvoice(tasks, billing, configManager.GetCategories())
+		invoice.Client = clientFlag
+		invoice.From = invoicePeriod.From
+		invoice.To = invoicePeriod.To
+
+		if len(invoice.Lines) == 0 {
+			printInfo(fmt.Sprintf("No billable tasks found for %s.", invoicePeriod.Label()))
+			return
+		}
+
+		if formatFlag == "text" && outputFlag == "" {
+			printInvoice(os.Stdout, invoice)
+			return
+		}
+
+		if outputFlag == "" {
+			timestamp := time.Now().Format("20060102-150405")
+			outputFlag = fmt.Sprintf("workflow-invoice-%s.%s", timestamp, formatFlag)
+		}
+		if err := writeInvoice(invoice, formatFlag, outputFlag); err != nil {
+			printError(fmt.Errorf("could not write invoice: %v", err))
+			return
+		}
+
+		absPath, _ := filepath.Abs(outputFlag)
+		printSuccess(fmt.Sprintf("Invoice for %s (%s) written to %s", invoicePeriod.Label(), formatMoney(invoice.Total, invoice.Currency), absPath))
+	},
+}
+
+// writeInvoice guarda la factura en un archivo con el formato indicado
+func writeInvoice(invoice core.Invoice, format string, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	switch format {
+	case "csv":
+		return writeInvoiceCSV(file, invoice)
+	case "json":
+		encoder := json.NewEncoder(file)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(invoice)
+	default:
+		printInvoice(file, invoice)
+		return nil
+	}
+}
+
+// printInvoice muestra la factura como texto
+func printInvoice(w io.Writer, invoice core.Invoice) {
+	title := "🧾 Invoice"
+	if invoice.Client != "" {
+		title += ": " + invoice.Client
+	}
+	fmt.Fprintf(w, "%s (%s to %s)\n", title, invoice.From, invoice.To)
+	fmt.Fprintf(w, "%s\n", strings.Repeat("=", 78))
+	fmt.Fprintf(w, "%-26s %-12s %6s %8s %10s %12s\n", "Project", "Category", "Tasks", "Hours", "Rate", "Amount")
+
+	unpriced := 0
+	for _, line := range invoice.Lines {
+		project := line.Project
+		if project == "" {
+			project = "(no project)"
+		}
+		fmt.Fprintf(w, "%-26s %-12s %6d %8.2f %10.2f %12.2f\n", truncate(project, 26), truncate(line.Category, 12),
+			line.Tasks, line.Hours, line.Rate, line.Amount)
+		if line.Rate == 0 {
+			unpriced++
+		}
+	}
+
+	fmt.Fprintf(w, "%s\n", strings.Repeat("-", 78))
+	fmt.Fprintf(w, "Billable hours: %.2fh\n", invoice.Hours)
+	fmt.Fprintf(w, "Total: %s\n", formatMoney(invoice.Total, invoice.Currency))
+	if invoice.Rounding > 0 {
+		fmt.Fprintf(w, "Hours per task rounded to %d minutes (%s)\n", invoice.Rounding, invoice.RoundingMode)
+	}
+	if invoice.NonBillableHours > 0 {
+		fmt.Fprintf(w, "Non-billable hours not invoiced: %.2fh\n", invoice.NonBillableHours)
+	}
+	if unpriced > 0 {
+		fmt.Fprintf(w, "⚠️  %d line(s) have no rate; set one with 'workflow rate set'\n", unpriced)
+	}
+}
+
+// writeInvoiceCSV escribe una fila por línea de la factura y una fila de total
+func writeInvoiceCSV(w io.Writer, invoice core.Invoice) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"Client", "From", "To", "Project", "Category", "Tasks", "Hours", "Rate", "Amount", "Currency"}); err != nil {
+		return err
+	}
+	for _, line := range invoice.Lines {
+		row := []string{
+			invoice.Client,
+			invoice.From,
+			invoice.To,
+			line.Project,
+			line.Category,
+			strconv.Itoa(line.Tasks),
+			strconv.FormatFloat(line.Hours, 'f', 2, 64),
+			strconv.FormatFloat(line.Rate, 'f', 2, 64),
+			strconv.FormatFloat(line.Amount, 'f', 2, 64),
+			invoice.Currency,
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	total := []string{invoice.Client, invoice.From, invoice.To, "Total", "", "",
+		strconv.FormatFloat(invoice.Hours, 'f', 2, 64), "",
+		strconv.FormatFloat(invoice.Total, 'f', 2, 64), invoice.Currency}
+	if err := writer.Write(total); err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// truncate acorta un texto a un ancho máximo para las columnas de texto
+func truncate(text string, width int) string {
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+func init() {
+	invoiceCmd.Flags().String("format", "text", "Output format (text, csv, json)")
+	invoiceCmd.Flags().String("month", "", "Month to invoice, YYYY-MM or a date in it (default: current month)")
+	invoiceCmd.Flags().String("from", "", "Start of a custom date range, inclusive ("+dateRangeFlagHelp+")")
+	invoiceCmd.Flags().String("to", "", "End of a custom date range, inclusive ("+dateRangeFlagHelp+")")
+	invoiceCmd.Flags().String("client", "", "Only invoice tasks of this client")
+	invoiceCmd.Flags().String("project", "", "Only invoice tasks of this project")
+	invoiceCmd.Flags().String("category", "", "Only invoice tasks of this category")
+	addTagFilterFlags(invoiceCmd)
+	invoiceCmd.Flags().Int("round", 0, "Round each task's hours to this many minutes (default: billing config)")
+	invoiceCmd.Flags().String("round-mode", "", "Rounding mode: nearest, up or down (default: billing config)")
+	invoiceCmd.Flags().String("output", "", "Output filename (default: text to the terminal, csv/json to workflow-invoice-YYYYMMDD-HHMMSS.format)")
+}
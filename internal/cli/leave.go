@@ -0,0 +1,292 @@
+package cli
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// leaveCmd agrupa los comandos de ausencias
+var leaveCmd = &cobra.Command{
+	Use:   "leave",
+	Short: "Track vacation, sick days and personal holidays",
+	Long: `Record absences so that they reduce the expected hours in 'status' and in reports.
+
+Examples:
+  workflow leave add 2025-08-04 2025-08-15 --type vacation
+  workflow leave add today --type sick
+  workflow leave add 2025-09-12 --type vacation --hours 4
+  workflow leave list --year 2025
+  workflow leave allowance vacation 22
+  workflow leave balance
+`,
+}
+
+// leaveAddCmd registra una ausencia
+var leaveAddCmd = &cobra.Command{
+	Use:   "add <from> [to]",
+	Short: "Record an absence between two dates (inclusive)",
+	Long: `Record an absence between two dates, both inclusive. Dates accept the same
+formats as --date (` + dateFlagHelp + `).
+Without --hours the absence covers whole days; with --hours it covers that many hours per day.
+
+Examples:
+  workflow leave add 2025-08-04 2025-08-15 --type vacation
+  workflow leave add yesterday --type sick
+  workflow leave add 2025-12-31 --type holiday --hours 4 --note "New Year's Eve"
+`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		leaveType, _ := cmd.Flags().GetString("type")
+		hours, _ := cmd.Flags().GetFloat64("hours")
+		note, _ := cmd.Flags().GetString("note")
+
+		from, err := parseDateFlag(args[0])
+		if err != nil {
+			printError(err)
+			return
+		}
+		to := from
+		if len(args) > 1 {
+			if to, err = parseDateFlag(args[1]); err != nil {
+				printError(err)
+				return
+			}
+		}
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		leave := &workflow.Leave{From: from, To: to, Type: leaveType, Hours: hours, Note: note}
+		if err := taskManager.AddLeave(leave); err != nil {
+			printError(err)
+			return
+		}
+
+		days := workCalendar().LeaveDays(*leave, leave.From, leave.To)
+		printSuccess(fmt.Sprintf("Recorded %s [%d] from %s to %s (%s)", leave.Type, leave.ID, leave.From, leave.To, formatLeaveDays(days)))
+	},
+}
+
+// leaveListCmd lista las ausencias
+var leaveListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List recorded absences",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		year, _ := cmd.Flags().GetInt("year")
+		from, to := yearRange(year)
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		leaves, err := taskManager.ListLeave(from, to)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		fmt.Println("🌴 Leave:")
+		if len(leaves) == 0 {
+			fmt.Println("  No leave recorded.")
+			return
+		}
+
+		calendar := workCalendar()
+		for _, leave := range leaves {
+			span := "full days"
+			if leave.Hours > 0 {
+				span = fmt.Sprintf("%.2fh/day", leave.Hours)
+			}
+			note := ""
+			if leave.Note != "" {
+				note = " - " + leave.Note
+			}
+			fmt.Printf("  [%d] %s to %s %s (%s, %s)%s\n", leave.ID, leave.From, leave.To, leave.Type, span,
+				formatLeaveDays(calendar.LeaveDays(leave, leave.From, leave.To)), note)
+		}
+	},
+}
+
+// leaveRemoveCmd elimina una ausencia
+var leaveRemoveCmd = &cobra.Command{
+	Use:   "remove <id>",
+	Short: "Remove a recorded absence",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			printError(fmt.Errorf("invalid leave ID: %s", args[0]))
+			return
+		}
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		if err := taskManager.RemoveLeave(id); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Leave %d removed", id))
+	},
+}
+
+// leaveAllowanceCmd fija los días de ausencia por año de un tipo
+var leaveAllowanceCmd = &cobra.Command{
+	Use:   "allowance <type> <days>",
+	Short: "Set the yearly allowance in days for a leave type (0 removes it)",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		days, err := strconv.ParseFloat(args[1], 64)
+		if err != nil {
+			printError(fmt.Errorf("invalid number of days: %s", args[1]))
+			return
+		}
+
+		configManager := core.LoadConfigManager()
+		if err := configManager.SetLeaveAllowance(args[0], days); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Yearly %s allowance set to %s", args[0], formatLeaveDays(days)))
+	},
+}
+
+// leaveBalanceCmd muestra los días usados y restantes por tipo de ausencia
+var leaveBalanceCmd = &cobra.Command{
+	Use:   "balance",
+	Short: "Show used and remaining leave days for the year",
+	Long: `Show the leave days used in a year (default: the current one) and, for types
+with a yearly allowance, the days remaining. Only working days count.
+
+Examples:
+  workflow leave balance
+  workflow leave balance --year 2024
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		year, _ := cmd.Flags().GetInt("year")
+		if year == 0 {
+			year = core.Now().Year()
+		}
+		from, to := yearRange(year)
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		leaves, err := taskManager.ListLeave(from, to)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		calendar := workCalendar()
+		used := make(map[string]float64)
+		for _, leave := range leaves {
+			used[leave.Type] += calendar.LeaveDays(leave, from, to)
+		}
+
+		allowance := core.LoadConfigManager().GetLeaveAllowance()
+
+		types := make(map[string]bool)
+		for leaveType := range used {
+			types[leaveType] = true
+		}
+		for leaveType := range allowance {
+			types[leaveType] = true
+		}
+		names := make([]string, 0, len(types))
+		for leaveType := range types {
+			names = append(names, leaveType)
+		}
+		sort.Strings(names)
+
+		fmt.Printf("🌴 Leave balance %d:\n", year)
+		if len(names) == 0 {
+			fmt.Println("  No leave recorded and no allowance configured.")
+			return
+		}
+
+		for _, leaveType := range names {
+			if days, limited := allowance[leaveType]; limited {
+				fmt.Printf("  %-10s used %s of %s, %s remaining\n", leaveType, formatLeaveDays(used[leaveType]),
+					formatLeaveDays(days), formatLeaveDays(days-used[leaveType]))
+			} else {
+				fmt.Printf("  %-10s used %s\n", leaveType, formatLeaveDays(used[leaveType]))
+			}
+		}
+	},
+}
+
+// calendarWithLeave devuelve el calendario laboral descontando las ausencias registradas entre dos fechas
+func calendarWithLeave(from string, to string) *core.Calendar {
+	calendar := workCalendar()
+
+	// El backend JSON no guarda ausencias
+	taskManager, err := core.NewSQLiteTaskStore()
+	if err != nil {
+		return calendar
+	}
+	defer taskManager.Close()
+
+	leaves, err := taskManager.ListLeave(from, to)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: %v, leave is not taken into account\n", err)
+		return calendar
+	}
+	return calendar.WithLeave(leaves)
+}
+
+// yearRange devuelve el primer y último día de un año; cero devuelve un rango sin límites
+func yearRange(year int) (string, string) {
+	if year == 0 {
+		return "", ""
+	}
+	return fmt.Sprintf("%04d-01-01", year), fmt.Sprintf("%04d-12-31", year)
+}
+
+// formatLeaveDays muestra una cantidad de días de ausencia
+func formatLeaveDays(days float64) string {
+	if days == 1 {
+		return "1 day"
+	}
+	return strconv.FormatFloat(math.Round(days*100)/100, 'f', -1, 64) + " days"
+}
+
+func init() {
+	leaveAddCmd.Flags().String("type", workflow.LeaveVacation, "Type of leave: vacation, sick or holiday")
+	leaveAddCmd.Flags().Float64("hours", 0, "Hours of leave per day (default: whole days)")
+	leaveAddCmd.Flags().String("note", "", "Note for the absence")
+	leaveListCmd.Flags().Int("year", 0, "Only list leave overlapping this year")
+	leaveBalanceCmd.Flags().Int("year", 0, "Year to show (default: current year)")
+
+	leaveCmd.AddCommand(leaveAddCmd)
+	leaveCmd.AddCommand(leaveListCmd)
+	leaveCmd.AddCommand(leaveRemoveCmd)
+	leaveCmd.AddCommand(leaveAllowanceCmd)
+	leaveCmd.AddCommand(leaveBalanceCmd)
+}
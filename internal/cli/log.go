@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// logCmd es el comando para registrar horas sobre una tarea existente
+var logCmd = &cobra.Command{
+	Use:   "log <task-id> [hours]",
+	Short: "Log hours on an existing task",
+	Long: `Log hours worked on an existing task for a specific day.
+
+The task's total hours are the sum of all its logged entries, and reports
+count each entry on the day the work happened. Without hours, the command
+lists the entries already logged on the task.
+
+Examples:
+  workflow log 12 1.5
+  workflow log 12 2.0 --date 2025-07-22
+  workflow log 12 0.5 --note "Follow-up with QA"
+  workflow log 12
+`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		taskID := parseTaskID(args[0])
+		if taskID == -1 {
+			printError(fmt.Errorf("invalid task ID: %s", args[0]))
+			return
+		}
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		if len(args) == 1 {
+			showTimeEntries(taskManager, taskID)
+			return
+		}
+
+		hours, err := parseHours(args[1])
+		if err != nil {
+			printError(fmt.Errorf("invalid hours: %s", args[1]))
+			return
+		}
+
+		dateFlag, _ := cmd.Flags().GetString("date")
+		noteFlag, _ := cmd.Flags().GetString("note")
+
+		date, err := parseDateFlag(dateFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		entry, err := taskManager.AddTimeEntry(taskID, hours, date, noteFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Logged %.2fh on task %d for %s", entry.Hours, taskID, entry.Date))
+		showTimeEntries(taskManager, taskID)
+	},
+}
+
+// showTimeEntries muestra una tarea con sus registros de horas
+func showTimeEntries(taskManager *core.TaskManagerSQLite, taskID int) {
+	task, err := taskManager.GetTaskByID(taskID)
+	if err != nil {
+		printError(err)
+		return
+	}
+
+	entries, err := taskManager.GetTimeEntries(taskID)
+	if err != nil {
+		printError(err)
+		return
+	}
+
+	fmt.Printf("\n[%d] %s %s (%.2fh total, %s) %s\n",
+		task.ID,
+		workflow.GetIcon(task.Category),
+		task.Description,
+		task.Hours,
+		task.Category,
+		workflow.GetStatusIcon(task.Status))
+
+	if len(entries) == 0 {
+		fmt.Println("  No time entries.")
+		return
+	}
+
+	for _, entry := range entries {
+		span := ""
+		if !entry.Start.IsZero() && !entry.End.IsZero() {
+			span = fmt.Sprintf(" %s-%s", entry.Start.In(core.Location()).Format("15:04"), entry.End.In(core.Location()).Format("15:04"))
+		}
+		note := ""
+		if entry.Note != "" {
+			note = " - " + entry.Note
+		}
+		fmt.Printf("  📅 %s%s: %.2fh%s\n", entry.Date, span, entry.Hours, note)
+	}
+}
+
+func init() {
+	logCmd.Flags().String("date", "", "Day the work happened ("+dateFlagHelp+"; default: today)")
+	logCmd.Flags().String("note", "", "Optional note for the entry")
+}
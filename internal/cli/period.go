@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+)
+
+// Tipos de período para reportes y exportaciones
+const (
+	periodToday = "today"
+	periodDate  = "date"
+	periodWeek  = "week"
+	periodMonth = "month"
+	periodRange = "range"
+)
+
+// period representa un rango de fechas inclusivo en formato YYYY-MM-DD
+type period struct {
+	Kind string
+	From string
+	To   string
+}
+
+// resolvePeriod determina el rango de fechas a partir de los flags de período
+func resolvePeriod(date string, week bool, month bool, from string, to string) (period, error) {
+	// Validar que solo se use un flag de período
+	periodFlagsCount := 0
+	if date != "" {
+		periodFlagsCount++
+	}
+	if week {
+		periodFlagsCount++
+	}
+	if month {
+		periodFlagsCount++
+	}
+	if from != "" || to != "" {
+		periodFlagsCount++
+	}
+
+	if periodFlagsCount > 1 {
+		return period{}, fmt.Errorf("only one period flag can be used at a time (--date, --week, --month, --from/--to)")
+	}
+
+	now := core.Now()
+
+	switch {
+	case date != "":
+		dateRange, err := core.ParseDateRange(date, now)
+		if err != nil {
+			return period{}, err
+		}
+		// Una semana ISO (2025-W30) se reporta como semana
+		kind := periodDate
+		if !dateRange.IsSingleDay() {
+			kind = periodWeek
+		}
+		return period{Kind: kind, From: dateRange.From, To: dateRange.To}, nil
+	case week:
+		return period{Kind: periodWeek, From: getWeekStart(), To: getWeekEnd()}, nil
+	case month:
+		return period{Kind: periodMonth, From: getMonthStart(), To: getMonthEnd()}, nil
+	case from != "" || to != "":
+		// Una semana ISO en --from empieza el lunes; en --to termina el domingo
+		result := period{Kind: periodRange}
+		if from != "" {
+			fromRange, err := core.ParseDateRange(from, now)
+			if err != nil {
+				return period{}, err
+			}
+			result.From = fromRange.From
+		}
+		if to != "" {
+			toRange, err := core.ParseDateRange(to, now)
+			if err != nil {
+				return period{}, err
+			}
+			result.To = toRange.To
+		}
+		if result.From != "" && result.To != "" && result.From > result.To {
+			return period{}, fmt.Errorf("--from (%s) must not be after --to (%s)", result.From, result.To)
+		}
+		return result, nil
+	default:
+		today := now.Format("2006-01-02")
+		return period{Kind: periodToday, From: today, To: today}, nil
+	}
+}
+
+// parseDateFlag interpreta el valor de un flag de fecha de un solo día; un valor vacío se devuelve sin cambios
+func parseDateFlag(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	return core.ParseDate(value, core.Now())
+}
+
+// parseDateRangeFlag interpreta el valor de un flag de fecha que acepta semanas ISO; un valor vacío no limita
+func parseDateRangeFlag(value string) (core.DateRange, error) {
+	if value == "" {
+		return core.DateRange{}, nil
+	}
+	return core.ParseDateRange(value, core.Now())
+}
+
+// parseMonthFlag devuelve el mes indicado como YYYY-MM o el que contiene una fecha; vacío es el mes actual
+func parseMonthFlag(value string) (period, error) {
+	now := core.Now()
+	month, err := time.ParseInLocation("2006-01", value, now.Location())
+	if err != nil {
+		date := now.Format("2006-01-02")
+		if value != "" {
+			if date, err = core.ParseDate(value, now); err != nil {
+				return period{}, fmt.Errorf("invalid month: %s (use YYYY-MM or a date)", value)
+			}
+		}
+		month, _ = time.ParseInLocation("2006-01-02", date, now.Location())
+	}
+
+	first := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	last := first.AddDate(0, 1, -1)
+	return period{Kind: periodMonth, From: first.Format("2006-01-02"), To: last.Format("2006-01-02")}, nil
+}
+
+// Formatos aceptados por los flags de fecha, para los textos de ayuda
+const (
+	dateFlagHelp      = "YYYY-MM-DD, today, yesterday, mon, last friday, -3d"
+	dateRangeFlagHelp = dateFlagHelp + " or an ISO week like 2025-W30"
+)
+
+// Label devuelve una descripción legible del rango
+func (p period) Label() string {
+	from := p.From
+	if from == "" {
+		from = "beginning"
+	}
+	to := p.To
+	if to == "" {
+		to = "latest"
+	}
+	return fmt.Sprintf("%s to %s", from, to)
+}
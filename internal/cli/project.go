@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+// projectCmd agrupa los comandos de gestión de proyectos
+var projectCmd = &cobra.Command{
+	Use:   "project",
+	Short: "Manage projects and clients",
+	Long: `Manage projects and the clients they are billed to.
+
+Examples:
+  workflow project add "Website redesign" --client "Acme Corp"
+  workflow project list
+  workflow project list --all
+  workflow project archive "Website redesign"
+`,
+}
+
+// projectAddCmd crea un nuevo proyecto
+var projectAddCmd = &cobra.Command{
+	Use:   "add <name>",
+	Short: "Add a new project",
+	Long: `Add a new project. The client is created automatically if it does not exist.
+
+Examples:
+  workflow project add "Website redesign" --client "Acme Corp"
+  workflow project add "Internal tools"
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, _ := cmd.Flags().GetString("client")
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		project, err := taskManager.AddProject(args[0], client)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		if project.Client != "" {
+			printSuccess(fmt.Sprintf("Added project: %s (client: %s)", project.Name, project.Client))
+		} else {
+			printSuccess(fmt.Sprintf("Added project: %s", project.Name))
+		}
+	},
+}
+
+// projectListCmd lista los proyectos
+var projectListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List projects",
+	Long: `List active projects grouped by client.
+
+Examples:
+  workflow project list
+  workflow project list --all
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		all, _ := cmd.Flags().GetBool("all")
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		projects, err := taskManager.ListProjects(all)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		fmt.Println("📁 Projects:")
+		if len(projects) == 0 {
+			fmt.Println("  No projects found.")
+			return
+		}
+
+		currentClient := "-"
+		for _, project := range projects {
+			if project.Client != currentClient {
+				currentClient = project.Client
+				if currentClient == "" {
+					fmt.Println("\n🏢 (no client)")
+				} else {
+					fmt.Printf("\n🏢 %s\n", currentClient)
+				}
+			}
+
+			archived := ""
+			if project.Archived {
+				archived = " (archived)"
+			}
+			fmt.Printf("  [%d] %s%s\n", project.ID, project.Name, archived)
+		}
+	},
+}
+
+// projectArchiveCmd archiva un proyecto
+var projectArchiveCmd = &cobra.Command{
+	Use:   "archive <name>",
+	Short: "Archive a project",
+	Long: `Archive a project so it no longer accepts new tasks.
+Existing tasks keep their project and still show up in reports.
+
+Examples:
+  workflow project archive "Website redesign"
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		if err := taskManager.ArchiveProject(args[0]); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Project %s archived", args[0]))
+	},
+}
+
+func init() {
+	projectAddCmd.Flags().String("client", "", "Client the project is billed to")
+	projectListCmd.Flags().Bool("all", false, "Include archived projects")
+
+	projectCmd.AddCommand(projectAddCmd)
+	projectCmd.AddCommand(projectListCmd)
+	projectCmd.AddCommand(projectArchiveCmd)
+}
@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// rateCmd agrupa los comandos de tarifas por hora
+var rateCmd = &cobra.Command{
+	Use:   "rate",
+	Short: "Manage hourly rates for invoices",
+	Long: `Manage the hourly rates used by 'workflow invoice'. A task is priced with the
+rate of its project, otherwise its client, otherwise its category, otherwise the
+default rate.
+
+Examples:
+  workflow rate set 80
+  workflow rate set 120 --client "Acme Corp"
+  workflow rate set 150 --project "Website redesign"
+  workflow rate set 60 --category meeting
+  workflow rate remove --category meeting
+  workflow rate currency EUR
+  workflow rate rounding 15 --mode up
+  workflow rate list
+`,
+}
+
+// rateSetCmd fija una tarifa por hora
+var rateSetCmd = &cobra.Command{
+	Use:   "set <amount>",
+	Short: "Set the default rate, or the rate of a project, client or category",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		rate, err := strconv.ParseFloat(args[0], 64)
+		if err != nil {
+			printError(fmt.Errorf("invalid rate: %s", args[0]))
+			return
+		}
+
+		scope, name, err := rateScope(cmd)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		configManager := core.LoadConfigManager()
+		if scope == core.RateCategory {
+			if err := configManager.ValidateCategory(name); err != nil {
+				printError(err)
+				return
+			}
+		}
+		if err := configManager.SetRate(scope, name, rate); err != nil {
+			printError(err)
+			return
+		}
+
+		currency := configManager.GetBilling().Currency
+		if scope == core.RateDefault {
+			printSuccess(fmt.Sprintf("Default rate set to %s/h", formatMoney(rate, currency)))
+		} else {
+			printSuccess(fmt.Sprintf("Rate for %s %s set to %s/h", scope, name, formatMoney(rate, currency)))
+		}
+	},
+}
+
+// rateRemoveCmd quita la tarifa de un proyecto, cliente o categoría
+var rateRemoveCmd = &cobra.Command{
+	Use:   "remove",
+	Short: "Remove the rate of a project, client or category",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		scope, name, err := rateScope(cmd)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		configManager := core.LoadConfigManager()
+		if err := configManager.RemoveRate(scope, name); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Rate for %s %s removed", scope, name))
+	},
+}
+
+// rateListCmd muestra las tarifas configuradas
+var rateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List hourly rates, currency and rounding",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		billing := core.LoadConfigManager().GetBilling()
+
+		fmt.Println("💰 Rates:")
+		fmt.Printf("  Default: %s/h\n", formatMoney(billing.DefaultRate, billing.Currency))
+		printRates("Projects", billing.ProjectRates, billing.Currency)
+		printRates("Clients", billing.ClientRates, billing.Currency)
+		printRates("Categories", billing.CategoryRates, billing.Currency)
+
+		if billing.Rounding > 0 {
+			fmt.Printf("\nRounding: %d minutes (%s)\n", billing.Rounding, billing.RoundingMode)
+		} else {
+			fmt.Println("\nRounding: none")
+		}
+	},
+}
+
+// rateCurrencyCmd fija la moneda de las facturas
+var rateCurrencyCmd = &cobra.Command{
+	Use:   "currency <code>",
+	Short: "Set the currency used for rates and invoices (e.g. USD, EUR)",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		configManager := core.LoadConfigManager()
+		if err := configManager.SetCurrency(args[0]); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Currency set to %s", configManager.GetBilling().Currency))
+	},
+}
+
+// rateRoundingCmd fija el redondeo de las horas facturadas
+var rateRoundingCmd = &cobra.Command{
+	Use:   "rounding <minutes>",
+	Short: "Round billed hours of each task to an increment in minutes (0 disables)",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		minutes, err := strconv.Atoi(args[0])
+		if err != nil {
+			printError(fmt.Errorf("invalid number of minutes: %s", args[0]))
+			return
+		}
+		mode, _ := cmd.Flags().GetString("mode")
+
+		configManager := core.LoadConfigManager()
+		if err := configManager.SetBillingRounding(minutes, mode); err != nil {
+			printError(err)
+			return
+		}
+
+		if minutes == 0 {
+			printSuccess("Billed hours are no longer rounded")
+		} else {
+			printSuccess(fmt.Sprintf("Billed hours are rounded to %d minutes (%s)", minutes, mode))
+		}
+	},
+}
+
+// rateScope devuelve el alcance y el nombre indicados con --project, --client o --category
+func rateScope(cmd *cobra.Command) (string, string, error) {
+	scope, name := core.RateDefault, ""
+	for _, flag := range []string{core.RateProject, core.RateClient, core.RateCategory} {
+		if !cmd.Flags().Changed(flag) {
+			continue
+		}
+		if scope != core.RateDefault {
+			return "", "", fmt.Errorf("only one of --project, --client or --category can be used")
+		}
+		scope = flag
+		name, _ = cmd.Flags().GetString(flag)
+	}
+
+	if scope == core.RateDefault && cmd.Name() == "remove" {
+		return "", "", fmt.Errorf("use --project, --client or --category to choose the rate to remove")
+	}
+	return scope, name, nil
+}
+
+// printRates muestra las tarifas de un alcance ordenadas por nombre
+func printRates(title string, rates map[string]float64, currency string) {
+	if len(rates) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(rates))
+	for name := range rates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Printf("\n%s:\n", title)
+	for _, name := range names {
+		fmt.Printf("  %-24s %s/h\n", name, formatMoney(rates[name], currency))
+	}
+}
+
+// addBillableFlags agrega los flags --billable y --no-billable de una tarea
+func addBillableFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("billable", false, "Invoice the task even if its category is non-billable")
+	cmd.Flags().Bool("no-billable", false, "Never invoice the task")
+}
+
+// readBillableFlags devuelve el valor facturable indicado y si se usó alguno de los flags
+func readBillableFlags(cmd *cobra.Command) (*bool, bool, error) {
+	billable, _ := cmd.Flags().GetBool("billable")
+	noBillable, _ := cmd.Flags().GetBool("no-billable")
+
+	switch {
+	case billable && noBillable:
+		return nil, false, fmt.Errorf("use either --billable or --no-billable")
+	case billable:
+		return &billable, true, nil
+	case noBillable:
+		value := false
+		return &value, true, nil
+	}
+	return nil, false, nil
+}
+
+// formatMoney muestra un importe con dos decimales y su moneda
+func formatMoney(amount float64, currency string) string {
+	return fmt.Sprintf("%.2f %s", amount, currency)
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{rateSetCmd, rateRemoveCmd} {
+		cmd.Flags().String(core.RateProject, "", "Project the rate applies to")
+		cmd.Flags().String(core.RateClient, "", "Client the rate applies to")
+		cmd.Flags().String(core.RateCategory, "", "Category the rate applies to")
+	}
+	rateRoundingCmd.Flags().String("mode", workflow.RoundNearest, "Rounding mode: nearest, up or down")
+
+	rateCmd.AddCommand(rateSetCmd)
+	rateCmd.AddCommand(rateRemoveCmd)
+	rateCmd.AddCommand(rateListCmd)
+	rateCmd.AddCommand(rateCurrencyCmd)
+	rateCmd.AddCommand(rateRoundingCmd)
+}
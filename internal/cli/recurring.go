@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// recurringCmd agrupa los comandos de tareas recurrentes
+var recurringCmd = &cobra.Command{
+	Use:   "recurring",
+	Short: "Manage recurring task templates",
+	Long: `Manage recurring task templates such as standups and sprint ceremonies.
+
+Rules: "daily", "weekdays", "every monday", "every mon,thu",
+"first business day of month", "last business day of month", "day 15 of month".
+Weekdays and business days follow the work calendar, so holidays are skipped.
+
+Examples:
+  workflow recurring add "Daily Standup" 0.25 daily --rule weekdays
+  workflow recurring add "Sprint planning" 2.0 meeting --rule "every monday"
+  workflow recurring list
+  workflow recurring apply
+  workflow recurring apply --until 2025-07-31
+  workflow recurring remove 2
+`,
+}
+
+// recurringAddCmd crea una plantilla recurrente
+var recurringAddCmd = &cobra.Command{
+	Use:   "add <description> <hours> [category]",
+	Short: "Add a recurring task template",
+	Long: `Add a recurring task template. Tasks are created by 'workflow recurring apply'.
+
+Examples:
+  workflow recurring add "Daily Standup" 0.25 daily --rule weekdays
+  workflow recurring add "Retro" 1.0 meeting --rule "every friday" --from 2025-07-01
+  workflow recurring add "Invoicing" 0.5 general --rule "first business day of month"
+`,
+	Args: cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		hours, err := parseHours(args[1])
+		if err != nil {
+			printError(fmt.Errorf("invalid hours: %s", args[1]))
+			return
+		}
+
+		category := workflow.DefaultCategory
+		if len(args) > 2 {
+			category = args[2]
+		}
+
+		rule, _ := cmd.Flags().GetString("rule")
+		fromFlag, _ := cmd.Flags().GetString("from")
+		from, err := parseDateFlag(fromFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		template := &workflow.RecurringTemplate{
+			Description: args[0],
+			Hours:       hours,
+			Category:    category,
+			Rule:        rule,
+			StartDate:   from,
+		}
+		if err := taskManager.AddRecurringTemplate(template); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Added recurring task [%d]: %s (%.2fh %s, %s from %s)",
+			template.ID, template.Description, template.Hours, template.Category, template.Rule, template.StartDate))
+	},
+}
+
+// recurringListCmd lista las plantillas recurrentes
+var recurringListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List recurring task templates",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		templates, err := taskManager.ListRecurringTemplates()
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		fmt.Println("🔁 Recurring tasks:")
+		if len(templates) == 0 {
+			fmt.Println("  No recurring tasks found.")
+			return
+		}
+
+		for _, template := range templates {
+			fmt.Printf("  [%d] %s %s (%.2fh, %s) - %s from %s\n", template.ID, workflow.GetIcon(template.Category),
+				template.Description, template.Hours, template.Category, template.Rule, template.StartDate)
+		}
+	},
+}
+
+// recurringRemoveCmd elimina una plantilla recurrente
+var recurringRemoveCmd = &cobra.Command{
+	Use:   "remove <id>",
+	Short: "Remove a recurring task template",
+	Long: `Remove a recurring task template. Tasks it already created are kept.
+
+Examples:
+  workflow recurring remove 2
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			printError(fmt.Errorf("invalid template ID: %s", args[0]))
+			return
+		}
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		if err := taskManager.RemoveRecurringTemplate(id); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Recurring task %d removed", id))
+	},
+}
+
+// recurringApplyCmd crea las tareas recurrentes pendientes
+var recurringApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Create due recurring tasks",
+	Long: `Create the tasks that are due for every recurring template, up to today or --until.
+It is safe to run repeatedly: each template creates at most one task per day.
+
+Examples:
+  workflow recurring apply
+  workflow recurring apply --until 2025-07-31
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		untilFlag, _ := cmd.Flags().GetString("until")
+		if untilFlag == "" {
+			untilFlag = "today"
+		}
+		until, err := parseDateFlag(untilFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		tasks, err := taskManager.ApplyRecurringTemplates(until)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		if len(tasks) == 0 {
+			printInfo(fmt.Sprintf("No recurring tasks due up to %s", until))
+			return
+		}
+
+		for _, task := range tasks {
+			fmt.Printf("  [%d] %s %s %s (%.2fh, %s)\n", task.ID, task.Date, workflow.GetIcon(task.Category),
+				task.Description, task.Hours, task.Category)
+		}
+		printSuccess(fmt.Sprintf("Created %d recurring task(s) up to %s", len(tasks), until))
+	},
+}
+
+func init() {
+	recurringAddCmd.Flags().String("rule", "weekdays", "Recurrence rule (e.g. weekdays, \"every monday\")")
+	recurringAddCmd.Flags().String("from", "", "First date to create tasks for ("+dateFlagHelp+"; default: today)")
+	recurringApplyCmd.Flags().String("until", "", "Create tasks up to this date ("+dateFlagHelp+"; default: today)")
+
+	recurringCmd.AddCommand(recurringAddCmd)
+	recurringCmd.AddCommand(recurringListCmd)
+	recurringCmd.AddCommand(recurringRemoveCmd)
+	recurringCmd.AddCommand(recurringApplyCmd)
+}
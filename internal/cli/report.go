@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// reportRenderer presenta un reporte en un formato de salida
+type reportRenderer interface {
+	Render(w io.Writer, report *core.Report) error
+}
+
+// reportRenderers son los renderizadores de reportes disponibles según el valor de --format
+var reportRenderers = map[string]reportRenderer{
+	"text": textReportRenderer{},
+	"md":   markdownReportRenderer{},
+	"html": htmlReportRenderer{},
+}
+
+// reportFormats devuelve los nombres de formato de reporte ordenados
+func reportFormats() []string {
+	formats := make([]string, 0, len(reportRenderers))
+	for format := range reportRenderers {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// reportTitle devuelve el título de un reporte según su período
+func reportTitle(report *core.Report) string {
+	reportPeriod := period{Kind: report.Kind, From: report.From, To: report.To}
+	switch report.Kind {
+	case periodWeek:
+		return fmt.Sprintf("Weekly Report (%s to %s)", report.From, report.To)
+	case periodMonth:
+		return fmt.Sprintf("Monthly Report (%s)", reportPeriod.Label())
+	case periodRange:
+		return fmt.Sprintf("Report (%s)", reportPeriod.Label())
+	default:
+		return fmt.Sprintf("Report for %s", report.From)
+	}
+}
+
+// reportEmptyMessage devuelve el mensaje de un reporte sin tareas
+func reportEmptyMessage(report *core.Report) string {
+	switch report.Kind {
+	case periodWeek:
+		return "No tasks found for this week."
+	case periodMonth, periodRange:
+		return "No tasks found for this period."
+	default:
+		return "No tasks found for this date."
+	}
+}
+
+// textReportRenderer presenta el reporte como texto con emojis para la terminal
+type textReportRenderer struct{}
+
+// Render escribe el reporte para la terminal
+func (textReportRenderer) Render(w io.Writer, report *core.Report) error {
+	fmt.Fprintf(w, "📊 %s\n", reportTitle(report))
+	fmt.Fprintf(w, "%s\n", strings.Repeat("=", 50))
+
+	if len(report.Tasks) == 0 {
+		fmt.Fprintf(w, "📝 %s\n", reportEmptyMessage(report))
+		return nil
+	}
+
+	switch report.Kind {
+	case periodWeek:
+		for _, day := range report.Days {
+			fmt.Fprintf(w, "\n📅 %s:\n", day.Date)
+			for _, task := range day.Tasks {
+				fmt.Fprintf(w, "  %s\n", formatReportTask(task))
+			}
+			fmt.Fprintf(w, "  Total: %.1fh\n", day.Hours)
+		}
+
+		fmt.Fprintf(w, "\n📈 Weekly Summary:\n")
+		fmt.Fprintf(w, "Total hours: %.1fh\n", report.TotalHours)
+		fmt.Fprintf(w, "Completed: %.1fh\n", report.CompletedHours)
+		fmt.Fprintf(w, "Completion rate: %.1f%%\n", report.CompletionRate())
+		writeTextExpected(w, report.Expected)
+		if len(report.Categories) > 1 {
+			writeTextGroups(w, "📊 By category", report.Categories, groupHours)
+		}
+	case periodMonth, periodRange:
+		summaryTitle := "Summary"
+		if report.Kind == periodMonth {
+			summaryTitle = "Monthly Summary"
+		}
+		fmt.Fprintf(w, "📈 %s:\n", summaryTitle)
+		fmt.Fprintf(w, "Total hours: %.1fh\n", report.TotalHours)
+		fmt.Fprintf(w, "Completed: %.1fh\n", report.CompletedHours)
+		fmt.Fprintf(w, "Completion rate: %.1f%%\n", report.CompletionRate())
+		fmt.Fprintf(w, "Total tasks: %d\n", report.TaskCount())
+		writeTextExpected(w, report.Expected)
+		writeTextGroups(w, "📊 By category", report.Categories, groupHours)
+		writeTextGroups(w, "📊 By status", report.Statuses, groupTasks)
+	default:
+		fmt.Fprintf(w, "📋 Tasks (%d):\n", len(report.Tasks))
+		for _, task := range report.Tasks {
+			fmt.Fprintf(w, "%s\n", formatReportTask(task))
+		}
+
+		fmt.Fprintf(w, "\n📈 Statistics:\n")
+		fmt.Fprintf(w, "Total hours: %.1fh\n", report.TotalHours)
+		fmt.Fprintf(w, "Completed: %.1fh\n", report.CompletedHours)
+		fmt.Fprintf(w, "Pending: %.1fh\n", report.PendingHours())
+		if len(report.Categories) > 1 {
+			writeTextGroups(w, "📊 By category", report.Categories, groupHours)
+		}
+		return nil
+	}
+
+	if len(report.Projects) > 0 {
+		writeTextGroups(w, "📁 By project", report.Projects, groupHours)
+	}
+	return nil
+}
+
+// formatReportTask muestra una tarea en una línea del reporte de texto
+func formatReportTask(task workflow.Task) string {
+	return fmt.Sprintf("[%d] %s %s (%.1fh, %s) %s",
+		task.ID,
+		workflow.GetIcon(task.Category),
+		task.Description,
+		task.Hours,
+		task.Category,
+		workflow.GetStatusIcon(task.Status))
+}
+
+// writeTextExpected muestra las horas esperadas, la diferencia y el saldo acumulado
+func writeTextExpected(w io.Writer, expected *core.ReportExpected) {
+	if expected == nil {
+		return
+	}
+	if leave := expected.Leave(); leave > 0 {
+		fmt.Fprintf(w, "Leave: %.1fh\n", leave)
+	}
+	fmt.Fprintf(w, "Expected hours: %.1fh\n", expected.Expected)
+	fmt.Fprintf(w, "Difference: %+.1fh\n", expected.Difference())
+	if expected.BalanceStart != "" {
+		fmt.Fprintf(w, "Flexitime balance since %s: %+.1fh\n", expected.BalanceStart, expected.Balance)
+	}
+}
+
+// writeTextGroups muestra un valor por cada grupo del reporte
+func writeTextGroups(w io.Writer, title string, groups []core.ReportGroup, value func(core.ReportGroup) string) {
+	fmt.Fprintf(w, "\n%s:\n", title)
+	for _, group := range groups {
+		fmt.Fprintf(w, "  %s: %s\n", group.Name, value(group))
+	}
+}
+
+// groupHours muestra las horas de un grupo
+func groupHours(group core.ReportGroup) string {
+	return fmt.Sprintf("%.1fh", group.Hours)
+}
+
+// groupTasks muestra la cantidad de tareas de un grupo
+func groupTasks(group core.ReportGroup) string {
+	return fmt.Sprintf("%d tasks", group.Tasks)
+}
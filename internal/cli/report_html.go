@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+)
+
+// maxChartDays es la cantidad máxima de días que el gráfico diario completa con ceros
+const maxChartDays = 92
+
+// htmlReportRenderer presenta el reporte como una página HTML autocontenida con gráficos SVG
+type htmlReportRenderer struct{}
+
+// chartBar es una barra de un gráfico con su etiqueta
+type chartBar struct {
+	Label string
+	Value float64
+}
+
+// htmlGroupTable es una tabla de horas por categoría, estado o proyecto
+type htmlGroupTable struct {
+	Title  string
+	Column string
+	Groups []core.ReportGroup
+}
+
+// Render escribe el reporte como HTML
+func (htmlReportRenderer) Render(w io.Writer, report *core.Report) error {
+	data := struct {
+		Title         string
+		Empty         string
+		Report        *core.Report
+		Summary       [][2]string
+		DailyChart    template.HTML
+		CategoryChart template.HTML
+		ShowDays      bool
+		ShowTasks     bool
+		Groups        []htmlGroupTable
+	}{
+		Title:     reportTitle(report),
+		Report:    report,
+		Summary:   reportSummaryRows(report),
+		ShowDays:  report.Kind == periodWeek,
+		ShowTasks: report.Kind != periodWeek && report.Kind != periodMonth && report.Kind != periodRange,
+		Groups: []htmlGroupTable{
+			{Title: "By category", Column: "Category", Groups: report.Categories},
+			{Title: "By status", Column: "Status", Groups: report.Statuses},
+			{Title: "By project", Column: "Project", Groups: report.Projects},
+		},
+	}
+
+	if len(report.Tasks) == 0 {
+		data.Empty = reportEmptyMessage(report)
+	} else {
+		if bars := reportDailyBars(report); len(bars) > 1 {
+			data.DailyChart = columnChartSVG(bars)
+		}
+		var categories []chartBar
+		for _, group := range report.Categories {
+			categories = append(categories, chartBar{Label: group.Name, Value: group.Hours})
+		}
+		data.CategoryChart = horizontalBarChartSVG(categories)
+	}
+
+	return reportHTMLTemplate.Execute(w, data)
+}
+
+// reportDailyBars devuelve las horas de cada día del reporte.
+// Los rangos cerrados de hasta maxChartDays días incluyen los días sin horas.
+func reportDailyBars(report *core.Report) []chartBar {
+	hours := make(map[string]float64, len(report.Days))
+	for _, day := range report.Days {
+		hours[day.Date] = day.Hours
+	}
+
+	from, fromErr := time.Parse("2006-01-02", report.From)
+	to, toErr := time.Parse("2006-01-02", report.To)
+	if fromErr != nil || toErr != nil || to.Sub(from).Hours()/24 >= maxChartDays {
+		bars := make([]chartBar, 0, len(report.Days))
+		for _, day := range report.Days {
+			bars = append(bars, chartBar{Label: day.Date, Value: day.Hours})
+		}
+		return bars
+	}
+
+	var bars []chartBar
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		date := day.Format("2006-01-02")
+		label := day.Format("02")
+		if to.Sub(from).Hours()/24 < 7 {
+			label = day.Format("Mon 02")
+		}
+		bars = append(bars, chartBar{Label: label, Value: hours[date]})
+	}
+	return bars
+}
+
+// columnChartSVG dibuja un gráfico de columnas verticales como SVG en línea
+func columnChartSVG(bars []chartBar) template.HTML {
+	const height, top, bottom, left = 200.0, 20.0, 30.0, 40.0
+	slot := math.Max(18, math.Min(60, 720/float64(len(bars))))
+	width := left + slot*float64(len(bars)) + 10
+	plot := height - top - bottom
+	maxValue := chartMax(bars)
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f" role="img" aria-label="Hours per day">`,
+		width, height, width, height)
+	fmt.Fprintf(&svg, `<line x1="%.0f" y1="%.0f" x2="%.0f" y2="%.0f" class="axis"/>`, left, height-bottom, width-10, height-bottom)
+	fmt.Fprintf(&svg, `<text x="%.0f" y="%.0f" class="tick" text-anchor="end">%s</text>`, left-6, top+4, formatChartValue(maxValue))
+	fmt.Fprintf(&svg, `<text x="%.0f" y="%.0f" class="tick" text-anchor="end">0</text>`, left-6, height-bottom+4)
+
+	for i, bar := range bars {
+		barHeight := plot * bar.Value / maxValue
+		x := left + slot*float64(i) + slot*0.15
+		y := height - bottom - barHeight
+		fmt.Fprintf(&svg, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" class="bar"><title>%s: %.2fh</title></rect>`,
+			x, y, slot*0.7, barHeight, template.HTMLEscapeString(bar.Label), bar.Value)
+		if bar.Value > 0 && slot >= 28 {
+			fmt.Fprintf(&svg, `<text x="%.1f" y="%.1f" class="value" text-anchor="middle">%s</text>`, x+slot*0.35, y-4, formatChartValue(bar.Value))
+		}
+		fmt.Fprintf(&svg, `<text x="%.1f" y="%.0f" class="tick" text-anchor="middle">%s</text>`,
+			x+slot*0.35, height-bottom+16, template.HTMLEscapeString(bar.Label))
+	}
+
+	svg.WriteString(`</svg>`)
+	return template.HTML(svg.String())
+}
+
+// horizontalBarChartSVG dibuja un gráfico de barras horizontales como SVG en línea
+func horizontalBarChartSVG(bars []chartBar) template.HTML {
+	const rowHeight, labelWidth, barWidth = 26.0, 140.0, 420.0
+	width := labelWidth + barWidth + 60
+	height := rowHeight*float64(len(bars)) + 10
+	maxValue := chartMax(bars)
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f" role="img" aria-label="Hours by category">`,
+		width, height, width, height)
+	for i, bar := range bars {
+		y := rowHeight*float64(i) + 5
+		length := barWidth * bar.Value / maxValue
+		fmt.Fprintf(&svg, `<text x="%.0f" y="%.1f" class="label" text-anchor="end">%s</text>`, labelWidth-8, y+15, template.HTMLEscapeString(bar.Label))
+		fmt.Fprintf(&svg, `<rect x="%.0f" y="%.1f" width="%.1f" height="%.0f" class="bar"><title>%s: %.2fh</title></rect>`,
+			labelWidth, y+2, length, rowHeight-8, template.HTMLEscapeString(bar.Label), bar.Value)
+		fmt.Fprintf(&svg, `<text x="%.1f" y="%.1f" class="value">%sh</text>`, labelWidth+length+6, y+15, formatChartValue(bar.Value))
+	}
+	svg.WriteString(`</svg>`)
+	return template.HTML(svg.String())
+}
+
+// chartMax devuelve el mayor valor de las barras, al menos 1 para no dividir por cero
+func chartMax(bars []chartBar) float64 {
+	maxValue := 1.0
+	for _, bar := range bars {
+		maxValue = math.Max(maxValue, bar.Value)
+	}
+	return maxValue
+}
+
+// formatChartValue muestra un valor del gráfico sin decimales innecesarios
+func formatChartValue(value float64) string {
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", value), ".0")
+}
+
+// reportHTMLTemplate es la página del reporte HTML; no depende de archivos ni recursos externos
+var reportHTMLTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"day": formatReportDay,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; color: #1f2328; max-width: 960px; margin: 2rem auto; padding: 0 1rem; }
+h1 { font-size: 1.6rem; border-bottom: 1px solid #d0d7de; padding-bottom: .4rem; }
+h2 { font-size: 1.2rem; margin-top: 2rem; }
+table { border-collapse: collapse; margin: .5rem 0; }
+th, td { border: 1px solid #d0d7de; padding: .3rem .7rem; text-align: left; }
+th { background: #f6f8fa; }
+td.num { text-align: right; font-variant-numeric: tabular-nums; }
+.empty { color: #656d76; font-style: italic; }
+.total { font-weight: bold; }
+svg { max-width: 100%; height: auto; }
+svg .bar { fill: #4f81bd; }
+svg .axis { stroke: #8c959f; }
+svg .tick, svg .label { font-size: 11px; fill: #57606a; }
+svg .value { font-size: 11px; fill: #1f2328; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{if .Empty}}<p class="empty">{{.Empty}}</p>{{else}}
+<h2>Summary</h2>
+<table>
+{{range .Summary}}<tr><th>{{index . 0}}</th><td class="num">{{index . 1}}</td></tr>
+{{end}}</table>
+{{if .DailyChart}}<h2>Hours per day</h2>
+{{.DailyChart}}
+{{end}}<h2>Hours by category</h2>
+{{.CategoryChart}}
+{{if .ShowDays}}{{range .Report.Days}}<h2>{{day .Date}}</h2>
+{{template "tasks" .Tasks}}<p class="total">Total: {{printf "%.1f" .Hours}}h</p>
+{{end}}{{end}}{{if .ShowTasks}}<h2>Tasks</h2>
+{{template "tasks" .Report.Tasks}}{{end}}
+{{range .Groups}}{{if .Groups}}<h2>{{.Title}}</h2>
+<table>
+<tr><th>{{.Column}}</th><th>Hours</th><th>Tasks</th></tr>
+{{range .Groups}}<tr><td>{{.Name}}</td><td class="num">{{printf "%.1f" .Hours}}</td><td class="num">{{.Tasks}}</td></tr>
+{{end}}</table>
+{{end}}{{end}}{{end}}</body>
+</html>
+{{define "tasks"}}<table>
+<tr><th>ID</th><th>Task</th><th>Category</th><th>Project</th><th>Hours</th><th>Status</th></tr>
+{{range .}}<tr><td class="num">{{.ID}}</td><td>{{.Description}}</td><td>{{.Category}}</td><td>{{.Project}}</td><td class="num">{{printf "%.1f" .Hours}}</td><td>{{.Status}}</td></tr>
+{{end}}</table>
+{{end}}`))
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// markdownReportRenderer presenta el reporte como Markdown con tablas, sin emojis
+type markdownReportRenderer struct{}
+
+// Render escribe el reporte en Markdown
+func (markdownReportRenderer) Render(w io.Writer, report *core.Report) error {
+	fmt.Fprintf(w, "# %s\n\n", markdownEscape(reportTitle(report)))
+
+	if len(report.Tasks) == 0 {
+		fmt.Fprintf(w, "_%s_\n", reportEmptyMessage(report))
+		return nil
+	}
+
+	switch report.Kind {
+	case periodWeek:
+		for _, day := range report.Days {
+			fmt.Fprintf(w, "## %s\n\n", formatReportDay(day.Date))
+			writeMarkdownTasks(w, day.Tasks)
+			fmt.Fprintf(w, "\n**Total: %.1fh**\n\n", day.Hours)
+		}
+	case periodMonth, periodRange:
+	default:
+		fmt.Fprintf(w, "## Tasks\n\n")
+		writeMarkdownTasks(w, report.Tasks)
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "## Summary\n\n")
+	fmt.Fprintf(w, "| Metric | Value |\n| --- | ---: |\n")
+	for _, row := range reportSummaryRows(report) {
+		fmt.Fprintf(w, "| %s | %s |\n", markdownEscape(row[0]), row[1])
+	}
+
+	writeMarkdownGroups(w, "By category", "Category", report.Categories)
+	writeMarkdownGroups(w, "By status", "Status", report.Statuses)
+	writeMarkdownGroups(w, "By project", "Project", report.Projects)
+	return nil
+}
+
+// writeMarkdownTasks escribe una tabla con las tareas indicadas
+func writeMarkdownTasks(w io.Writer, tasks []workflow.Task) {
+	fmt.Fprintf(w, "| ID | Task | Category | Project | Hours | Status |\n")
+	fmt.Fprintf(w, "| ---: | --- | --- | --- | ---: | --- |\n")
+	for _, task := range tasks {
+		fmt.Fprintf(w, "| %d | %s | %s | %s | %.1f | %s |\n",
+			task.ID, markdownEscape(task.Description), markdownEscape(task.Category),
+			markdownEscape(task.Project), task.Hours, task.Status)
+	}
+}
+
+// writeMarkdownGroups escribe una tabla de horas y tareas por grupo
+func writeMarkdownGroups(w io.Writer, title string, column string, groups []core.ReportGroup) {
+	if len(groups) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n## %s\n\n", title)
+	fmt.Fprintf(w, "| %s | Hours | Tasks |\n| --- | ---: | ---: |\n", column)
+	for _, group := range groups {
+		fmt.Fprintf(w, "| %s | %.1f | %d |\n", markdownEscape(group.Name), group.Hours, group.Tasks)
+	}
+}
+
+// reportSummaryRows devuelve las filas del resumen para los formatos de tabla
+func reportSummaryRows(report *core.Report) [][2]string {
+	rows := [][2]string{
+		{"Total hours", fmt.Sprintf("%.1fh", report.TotalHours)},
+		{"Completed", fmt.Sprintf("%.1fh", report.CompletedHours)},
+		{"Pending", fmt.Sprintf("%.1fh", report.PendingHours())},
+		{"Completion rate", fmt.Sprintf("%.1f%%", report.CompletionRate())},
+		{"Tasks", fmt.Sprintf("%d", report.TaskCount())},
+	}
+
+	if expected := report.Expected; expected != nil {
+		if leave := expected.Leave(); leave > 0 {
+			rows = append(rows, [2]string{"Leave", fmt.Sprintf("%.1fh", leave)})
+		}
+		rows = append(rows,
+			[2]string{"Expected hours", fmt.Sprintf("%.1fh", expected.Expected)},
+			[2]string{"Difference", fmt.Sprintf("%+.1fh", expected.Difference())})
+		if expected.BalanceStart != "" {
+			rows = append(rows, [2]string{"Flexitime balance since " + expected.BalanceStart, fmt.Sprintf("%+.1fh", expected.Balance)})
+		}
+	}
+
+	return rows
+}
+
+// formatReportDay muestra una fecha con su día de la semana
+func formatReportDay(date string) string {
+	if t, err := time.Parse("2006-01-02", date); err == nil {
+		return t.Format("Monday 2006-01-02")
+	}
+	return date
+}
+
+// markdownEscape escapa los caracteres que rompen una celda o el formato de Markdown
+var markdownEscape = strings.NewReplacer(
+	`\`, `\\`,
+	"|", `\|`,
+	"*", `\*`,
+	"_", `\_`,
+	"`", "\\`",
+	"\n", " ",
+).Replace
@@ -0,0 +1,133 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// registerShortcutCommands agrega al comando raíz los comandos rápidos definidos en la configuración
+func registerShortcutCommands() {
+	configManager := core.LoadConfigManager()
+
+	var registered []workflow.Shortcut
+	for _, shortcut := range configManager.GetShortcuts() {
+		if shortcut.Name == "" || strings.ContainsAny(shortcut.Name, " \t") {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: Ignoring shortcut with invalid name '%s'\n", shortcut.Name)
+			continue
+		}
+		if existing, _, err := rootCmd.Find([]string{shortcut.Name}); err == nil && existing != rootCmd {
+			fmt.Fprintf(os.Stderr, "⚠️  Warning: Shortcut '%s' conflicts with a built-in command and was ignored\n", shortcut.Name)
+			continue
+		}
+
+		rootCmd.AddCommand(newShortcutCommand(shortcut, configManager))
+		registered = append(registered, shortcut)
+	}
+
+	cobra.AddTemplateFunc("shortcutHelp", func() string {
+		return shortcutHelp(registered)
+	})
+}
+
+// newShortcutCommand construye el comando cobra de un atajo
+func newShortcutCommand(shortcut workflow.Shortcut, configManager *core.ConfigManager) *cobra.Command {
+	takesInput := strings.Contains(shortcut.Description, workflow.ShortcutInput)
+	defaultHours := shortcutDefaultHours(shortcut, configManager)
+
+	use := shortcut.Name
+	if takesInput {
+		use += " [description]"
+	}
+	if defaultHours > 0 {
+		use += " [hours]"
+	} else {
+		use += " <hours>"
+	}
+
+	help := shortcut.Help
+	if help == "" {
+		help = fmt.Sprintf("Add a %s task", shortcut.Category)
+	}
+
+	minArgs, maxArgs := 0, 1
+	if takesInput {
+		minArgs, maxArgs = 1, 2
+	}
+	if defaultHours <= 0 {
+		minArgs++
+	}
+
+	return &cobra.Command{
+		Use:   use,
+		Short: help,
+		Long: fmt.Sprintf(`%s (category: %s).
+
+This shortcut is defined in ~/.workflow/config.json.`, help, shortcut.Category),
+		Args: cobra.RangeArgs(minArgs, maxArgs),
+		Run: func(cmd *cobra.Command, args []string) {
+			description := shortcut.Description
+			if takesInput {
+				description = strings.ReplaceAll(description, workflow.ShortcutInput, args[0])
+				args = args[1:]
+			}
+
+			hours := defaultHours
+			if len(args) > 0 {
+				var err error
+				if hours, err = parseHours(args[0]); err != nil {
+					printError(fmt.Errorf("invalid hours: %s", args[0]))
+					return
+				}
+			}
+
+			taskManager := core.NewTaskStore()
+			defer taskManager.Close()
+
+			if err := taskManager.ValidateCategory(shortcut.Category); err != nil {
+				printError(fmt.Errorf("shortcut '%s': %v", shortcut.Name, err))
+				return
+			}
+
+			if err := taskManager.AddTask(description, hours, shortcut.Category, ""); err != nil {
+				printError(err)
+				return
+			}
+
+			printSuccess(fmt.Sprintf("Added %s task: %s (%.2fh)", shortcut.Name, description, hours))
+			showStatus(taskManager)
+		},
+	}
+}
+
+// shortcutDefaultHours devuelve las horas por defecto del atajo o, si no tiene, las de su categoría.
+// Los atajos de la categoría daily usan daily_standup_hours.
+func shortcutDefaultHours(shortcut workflow.Shortcut, configManager *core.ConfigManager) float64 {
+	if shortcut.Hours > 0 {
+		return shortcut.Hours
+	}
+	if shortcut.Category == "daily" && configManager.GetDailyStandupHours() > 0 {
+		return configManager.GetDailyStandupHours()
+	}
+	if category, exists := configManager.FindCategory(shortcut.Category); exists {
+		return category.DefaultHours
+	}
+	return 0
+}
+
+// shortcutHelp genera las líneas de ayuda de los atajos registrados
+func shortcutHelp(shortcuts []workflow.Shortcut) string {
+	var builder strings.Builder
+	for _, shortcut := range shortcuts {
+		help := shortcut.Help
+		if help == "" {
+			help = fmt.Sprintf("Add a %s task", shortcut.Category)
+		}
+		fmt.Fprintf(&builder, "  %-11s %s\n", shortcut.Name, help)
+	}
+	return builder.String()
+}
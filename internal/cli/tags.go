@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+// addTagFilterFlags agrega los flags de filtro por etiquetas a un comando
+func addTagFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray("tag", nil, "Only tasks with this tag (repeatable, all must match)")
+	cmd.Flags().StringArray("any-tag", nil, "Only tasks with any of these tags (repeatable)")
+}
+
+// readTagFilters lee y normaliza los flags --tag (AND) y --any-tag (OR)
+func readTagFilters(cmd *cobra.Command) ([]string, []string, error) {
+	tagFlags, _ := cmd.Flags().GetStringArray("tag")
+	anyTagFlags, _ := cmd.Flags().GetStringArray("any-tag")
+
+	tags, err := core.NormalizeTags(tagFlags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	anyTags, err := core.NormalizeTags(anyTagFlags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tags, anyTags, nil
+}
+
+// formatTags formatea etiquetas para mostrarlas al final de una línea de tarea
+func formatTags(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	return " #" + strings.Join(tags, " #")
+}
+
+// editTags agrega y quita etiquetas de una lista existente
+func editTags(current []string, add []string, remove []string) ([]string, error) {
+	removeTags, err := core.NormalizeTags(remove)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make(map[string]bool)
+	for _, tag := range removeTags {
+		removed[tag] = true
+	}
+
+	var kept []string
+	for _, tag := range current {
+		if !removed[tag] {
+			kept = append(kept, tag)
+		}
+	}
+
+	return core.NormalizeTags(append(kept, add...))
+}
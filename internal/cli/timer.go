@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// startCmd es el comando para iniciar un temporizador
+var startCmd = &cobra.Command{
+	Use:   "start [description] [category]",
+	Short: "Start a timer for a task",
+	Long: `Start a timer that turns into task hours when stopped.
+
+Only one timer can run at a time. Use --task to keep adding time to an
+existing task instead of creating a new one.
+
+Examples:
+  workflow start "API development" tech
+  workflow start "Code review"
+  workflow start --task 12
+`,
+	Args: cobra.MaximumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		taskID, _ := cmd.Flags().GetInt("task")
+
+		description := ""
+		if len(args) > 0 {
+			description = args[0]
+		}
+
+		// Categoría por defecto
+		category := workflow.DefaultCategory
+		if len(args) > 1 {
+			category = args[1]
+		}
+
+		if description == "" && taskID == 0 {
+			printError(fmt.Errorf("a description or --task is required"))
+			return
+		}
+
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		timer, err := taskManager.StartTimer(description, category, taskID)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Timer started: %s %s (%s) at %s",
+			workflow.GetIcon(timer.Category), timer.Description, timer.Category, timer.StartedAt.In(core.Location()).Format("15:04")))
+	},
+}
+
+// stopCmd es el comando para detener el temporizador
+var stopCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop the running timer and record its hours",
+	Long: `Stop the running timer and record the measured time as task hours.
+If the timer's task was deleted meanwhile, the time is recorded on a new task.
+
+The measured time is rounded using timer_rounding_minutes and
+timer_rounding_mode (nearest, up, down) from your config.
+
+Examples:
+  workflow stop
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		task, err := taskManager.StopTimer()
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Timer stopped: [%d] %s (%.2fh total, %s)",
+			task.ID, task.Description, task.Hours, task.Category))
+		showStatus(taskManager)
+	},
+}
+
+// pauseCmd es el comando para pausar el temporizador
+var pauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause the running timer",
+	Long: `Pause the running timer. If the timer belongs to a task, the task is
+marked as paused too.
+
+Examples:
+  workflow pause
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		timer, err := taskManager.PauseTimer()
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Timer paused: %s (%s elapsed)", timer.Description, formatElapsed(timer.Elapsed(time.Now()))))
+	},
+}
+
+// resumeCmd es el comando para reanudar el temporizador
+var resumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume the paused timer",
+	Long: `Resume a paused timer. If the timer belongs to a task, the task is
+marked as in progress again.
+
+Examples:
+  workflow resume
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		timer, err := taskManager.ResumeTimer()
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Timer resumed: %s (%s elapsed)", timer.Description, formatElapsed(timer.Elapsed(time.Now()))))
+	},
+}
+
+// timerCmd agrupa los comandos de gestión del temporizador
+var timerCmd = &cobra.Command{
+	Use:   "timer",
+	Short: "Manage the running timer",
+	Long: `Manage the running timer. Use start, stop, pause and resume to measure time.
+
+Examples:
+  workflow timer cancel
+`,
+}
+
+// timerCancelCmd descarta el temporizador sin registrar horas
+var timerCancelCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "Discard the running timer without recording hours",
+	Long: `Discard the running timer without recording any hours. If the timer
+belongs to a task that was in progress or paused, the task is marked as
+pending again.
+
+Examples:
+  workflow timer cancel
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		taskManager, err := core.NewSQLiteTaskStore()
+		if err != nil {
+			printError(err)
+			return
+		}
+		defer taskManager.Close()
+
+		timer, err := taskManager.CancelTimer()
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Timer cancelled: %s (%s discarded)", timer.Description, formatElapsed(timer.Elapsed(time.Now()))))
+	},
+}
+
+// showActiveTimer muestra el temporizador en curso si el backend lo soporta
+func showActiveTimer(taskManager core.TaskStore) {
+	sqliteManager, ok := taskManager.(*core.TaskManagerSQLite)
+	if !ok {
+		return
+	}
+
+	timer, err := sqliteManager.GetActiveTimer()
+	if err != nil || timer == nil {
+		return
+	}
+
+	state := "running"
+	if timer.Paused {
+		state = "paused"
+	}
+
+	fmt.Printf("⏱️  Timer %s: %s %s (%s) - %s since %s\n",
+		state,
+		workflow.GetIcon(timer.Category),
+		timer.Description,
+		timer.Category,
+		formatElapsed(timer.Elapsed(time.Now())),
+		timer.StartedAt.In(core.Location()).Format("15:04"))
+}
+
+// formatElapsed formatea una duración como horas y minutos
+func formatElapsed(d time.Duration) string {
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+}
+
+func init() {
+	startCmd.Flags().Int("task", 0, "Add the measured time to an existing task ID")
+	timerCmd.AddCommand(timerCancelCmd)
+}
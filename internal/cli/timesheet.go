@@ -0,0 +1,237 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+// timesheetCmd muestra las horas de una semana como grilla de grupos por días
+var timesheetCmd = &cobra.Command{
+	Use:   "timesheet",
+	Short: "Show a week as a grid of hours per group and day",
+	Long: `Show the hours of a week as a timesheet grid: one row per category, project
+or description, one column per day, and totals for each row and day.
+
+The week starts on the first day configured in the work calendar. By default
+it is the current week; --from selects the week that contains a date.
+
+Formats:
+- text: printed to the terminal (default)
+- csv: plain CSV with a total row and column
+- xlsx-csv: CSV for spreadsheets such as Excel, with a UTF-8 byte order mark
+  and CRLF line endings
+
+Examples:
+  workflow timesheet --week
+  workflow timesheet --from 2025-07-21 --group-by project
+  workflow timesheet --from 2025-W30 --format xlsx-csv --output timesheet.csv
+  workflow timesheet --group-by description --format csv
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fromFlag, _ := cmd.Flags().GetString("from")
+		groupByFlag, _ := cmd.Flags().GetString("group-by")
+		formatFlag, _ := cmd.Flags().GetString("format")
+		outputFlag, _ := cmd.Flags().GetString("output")
+
+		if formatFlag != "text" && formatFlag != "csv" && formatFlag != "xlsx-csv" {
+			printError(fmt.Errorf("unsupported format: %s. Supported formats: text, csv, xlsx-csv", formatFlag))
+			return
+		}
+
+		// Sin --from se usa la semana actual
+		weekPeriod := period{Kind: periodWeek, From: getWeekStart(), To: getWeekEnd()}
+		if fromFlag != "" {
+			dateRange, err := parseDateRangeFlag(fromFlag)
+			if err != nil {
+				printError(err)
+				return
+			}
+			from, _ := time.ParseInLocation("2006-01-02", dateRange.From, core.Location())
+			start := workCalendar().StartOfWeek(from)
+			weekPeriod.From = start.Format("2006-01-02")
+			weekPeriod.To = start.AddDate(0, 0, 6).Format("2006-01-02")
+		}
+
+		tasks, err := loadExportTasks(weekPeriod, core.TaskFilter{})
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		timesheet, err := core.NewTimesheet(core.NewReport(weekPeriod.Kind, weekPeriod.From, weekPeriod.To, tasks), groupByFlag)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		if formatFlag == "text" && outputFlag == "" {
+			printTimesheet(os.Stdout, timesheet)
+			return
+		}
+
+		if outputFlag == "" {
+			timestamp := time.Now().Format("20060102-150405")
+			outputFlag = fmt.Sprintf("workflow-timesheet-%s.%s", timestamp, strings.TrimPrefix(formatFlag, "xlsx-"))
+		}
+		if err := writeTimesheet(timesheet, formatFlag, outputFlag); err != nil {
+			printError(fmt.Errorf("could not write timesheet: %v", err))
+			return
+		}
+
+		absPath, _ := filepath.Abs(outputFlag)
+		printSuccess(fmt.Sprintf("Timesheet for %s to %s (%.2fh) written to %s", timesheet.From, timesheet.To, timesheet.Total, absPath))
+	},
+}
+
+// writeTimesheet guarda la hoja de horas en un archivo con el formato indicado
+func writeTimesheet(timesheet *core.Timesheet, format string, filename string) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	switch format {
+	case "csv":
+		err = writeTimesheetCSV(file, timesheet, false)
+	case "xlsx-csv":
+		err = writeTimesheetCSV(file, timesheet, true)
+	default:
+		printTimesheet(file, timesheet)
+	}
+	if err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// printTimesheet muestra la hoja de horas como una grilla de texto
+func printTimesheet(w io.Writer, timesheet *core.Timesheet) {
+	const nameWidth, cellWidth = 24, 8
+	width := nameWidth + (cellWidth+1)*(len(timesheet.Dates)+1)
+
+	fmt.Fprintf(w, "🗓️  Timesheet (%s to %s)\n", timesheet.From, timesheet.To)
+	fmt.Fprintf(w, "%s\n", strings.Repeat("=", width))
+
+	fmt.Fprintf(w, "%-*s", nameWidth, timesheetGroupTitle(timesheet.GroupBy))
+	for _, date := range timesheet.Dates {
+		fmt.Fprintf(w, " %*s", cellWidth, timesheetDayLabel(date))
+	}
+	fmt.Fprintf(w, " %*s\n", cellWidth, "Total")
+
+	if len(timesheet.Rows) == 0 {
+		fmt.Fprintf(w, "📝 No tasks found for this week.\n")
+		return
+	}
+
+	for _, row := range timesheet.Rows {
+		fmt.Fprintf(w, "%-*s", nameWidth, truncate(row.Name, nameWidth))
+		for _, hours := range row.Hours {
+			fmt.Fprintf(w, " %*s", cellWidth, timesheetCell(hours, "-"))
+		}
+		fmt.Fprintf(w, " %*.2f\n", cellWidth, row.Total)
+	}
+
+	fmt.Fprintf(w, "%s\n", strings.Repeat("-", width))
+	fmt.Fprintf(w, "%-*s", nameWidth, "Total")
+	for _, hours := range timesheet.DayTotals {
+		fmt.Fprintf(w, " %*s", cellWidth, timesheetCell(hours, "-"))
+	}
+	fmt.Fprintf(w, " %*.2f\n", cellWidth, timesheet.Total)
+}
+
+// writeTimesheetCSV escribe la grilla con una fila de totales.
+// Para hojas de cálculo agrega la marca BOM, usa CRLF y evita que un nombre se lea como fórmula.
+func writeTimesheetCSV(w io.Writer, timesheet *core.Timesheet, spreadsheet bool) error {
+	if spreadsheet {
+		if _, err := io.WriteString(w, "\ufeff"); err != nil {
+			return err
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = spreadsheet
+
+	header := []string{timesheetGroupTitle(timesheet.GroupBy)}
+	for _, date := range timesheet.Dates {
+		header = append(header, timesheetColumnTitle(date))
+	}
+	header = append(header, "Total")
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, row := range timesheet.Rows {
+		name := row.Name
+		if spreadsheet && strings.IndexAny(name, "=+-@") == 0 {
+			name = "'" + name
+		}
+		record := []string{name}
+		for _, hours := range row.Hours {
+			record = append(record, timesheetCell(hours, ""))
+		}
+		record = append(record, strconv.FormatFloat(row.Total, 'f', 2, 64))
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	total := []string{"Total"}
+	for _, hours := range timesheet.DayTotals {
+		total = append(total, strconv.FormatFloat(hours, 'f', 2, 64))
+	}
+	total = append(total, strconv.FormatFloat(timesheet.Total, 'f', 2, 64))
+	if err := writer.Write(total); err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// timesheetGroupTitle devuelve el encabezado de la columna de grupos
+func timesheetGroupTitle(groupBy string) string {
+	return strings.ToUpper(groupBy[:1]) + groupBy[1:]
+}
+
+// timesheetDayLabel muestra una fecha como día de la semana y del mes ("Mon 21")
+func timesheetDayLabel(date string) string {
+	if t, err := time.Parse("2006-01-02", date); err == nil {
+		return t.Format("Mon 02")
+	}
+	return date
+}
+
+// timesheetColumnTitle devuelve el encabezado CSV de un día ("Mon 2025-07-21")
+func timesheetColumnTitle(date string) string {
+	if t, err := time.Parse("2006-01-02", date); err == nil {
+		return t.Format("Mon 2006-01-02")
+	}
+	return date
+}
+
+// timesheetCell muestra las horas de una celda; las celdas sin horas muestran empty
+func timesheetCell(hours float64, empty string) string {
+	if hours == 0 {
+		return empty
+	}
+	return strconv.FormatFloat(hours, 'f', 2, 64)
+}
+
+func init() {
+	timesheetCmd.Flags().Bool("week", true, "Show a week (the only period a timesheet covers)")
+	timesheetCmd.Flags().String("from", "", "Show the week that contains this date ("+dateRangeFlagHelp+"; default: current week)")
+	timesheetCmd.Flags().String("group-by", core.TimesheetByCategory, "Row grouping: category, project or description")
+	timesheetCmd.Flags().String("format", "text", "Output format (text, csv, xlsx-csv)")
+	timesheetCmd.Flags().String("output", "", "Output filename (default: text to the terminal, csv to workflow-timesheet-YYYYMMDD-HHMMSS.csv)")
+}
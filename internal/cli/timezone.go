@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/spf13/cobra"
+)
+
+// applyTimezone fija la zona horaria de la ejecución; --tz tiene prioridad sobre la configuración
+func applyTimezone(cmd *cobra.Command, args []string) error {
+	if tz, _ := cmd.Flags().GetString("tz"); tz != "" {
+		if err := core.SetTimezone(tz); err != nil {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+			return err
+		}
+		return nil
+	}
+
+	configManager := core.LoadConfigManager()
+	if err := core.SetTimezone(configManager.Get().Timezone); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: %v in config, using the system timezone\n", err)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.PersistentPreRunE = applyTimezone
+	rootCmd.PersistentFlags().String("tz", "", "Timezone for today, week and month boundaries (IANA name, overrides config)")
+}
@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/internal/core"
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+	"github.com/spf13/cobra"
+)
+
+// trashCmd agrupa los comandos de la papelera
+var trashCmd = &cobra.Command{
+	Use:   "trash",
+	Short: "List or empty the trash",
+	Long: `Deleted tasks are kept in the trash and hidden from lists, searches and reports.
+
+Examples:
+  workflow trash list
+  workflow trash empty --older-than 30d
+  workflow restore 12
+`,
+}
+
+// trashListCmd lista las tareas de la papelera
+var trashListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List tasks in the trash",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		taskManager := core.NewTaskStore()
+		defer taskManager.Close()
+
+		tasks, err := taskManager.ListTrash()
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		fmt.Println("🗑️  Trash:")
+		if len(tasks) == 0 {
+			fmt.Println("  Trash is empty.")
+			return
+		}
+
+		for _, task := range tasks {
+			fmt.Printf("  [%d] %s %s (%.1fh, %s, %s) - deleted %s\n", task.ID, workflow.GetIcon(task.Category),
+				task.Description, task.Hours, task.Category, task.Date, task.DeletedAt.In(core.Location()).Format("2006-01-02 15:04"))
+		}
+	},
+}
+
+// trashEmptyCmd elimina definitivamente tareas de la papelera
+var trashEmptyCmd = &cobra.Command{
+	Use:   "empty",
+	Short: "Permanently delete tasks in the trash",
+	Long: `Permanently delete tasks in the trash. Use --older-than to keep recent deletions.
+Emptied tasks and their history are gone for good: 'workflow undo' cannot bring them back.
+
+Examples:
+  workflow trash empty
+  workflow trash empty --older-than 30d
+  workflow trash empty --older-than 2w --force
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		olderThan, _ := cmd.Flags().GetString("older-than")
+
+		age, err := parseAge(olderThan)
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		if !confirm(cmd, "Permanently delete tasks in the trash?") {
+			printInfo("Empty trash cancelled")
+			return
+		}
+
+		taskManager := core.NewTaskStore()
+		defer taskManager.Close()
+
+		purged, err := taskManager.EmptyTrash(time.Now().Add(-age))
+		if err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Permanently deleted %d task(s) from the trash", purged))
+	},
+}
+
+// restoreCmd saca una tarea de la papelera
+var restoreCmd = &cobra.Command{
+	Use:   "restore <id>",
+	Short: "Restore a task from the trash",
+	Long: `Restore a deleted task from the trash.
+
+Examples:
+  workflow restore 12
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			printError(fmt.Errorf("invalid task ID: %s", args[0]))
+			return
+		}
+
+		taskManager := core.NewTaskStore()
+		defer taskManager.Close()
+
+		if err := taskManager.RestoreTask(id); err != nil {
+			printError(err)
+			return
+		}
+
+		printSuccess(fmt.Sprintf("Task %d restored", id))
+	},
+}
+
+// parseAge interpreta una antigüedad como 30d, 2w o una duración de Go (12h)
+func parseAge(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	units := map[byte]time.Duration{'d': 24 * time.Hour, 'w': 7 * 24 * time.Hour}
+	if unit, ok := units[value[len(value)-1]]; ok {
+		n, err := strconv.Atoi(value[:len(value)-1])
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid age: %s (use e.g. 30d, 2w or 12h)", value)
+		}
+		return time.Duration(n) * unit, nil
+	}
+
+	age, err := time.ParseDuration(value)
+	if err != nil || age < 0 {
+		return 0, fmt.Errorf("invalid age: %s (use e.g. 30d, 2w or 12h)", value)
+	}
+	return age, nil
+}
+
+func init() {
+	trashEmptyCmd.Flags().String("older-than", "", "Only delete tasks trashed longer ago than this (e.g. 30d, 2w, 12h)")
+	trashEmptyCmd.Flags().Bool("force", false, "Empty the trash without confirmation")
+
+	trashCmd.AddCommand(trashListCmd)
+	trashCmd.AddCommand(trashEmptyCmd)
+}
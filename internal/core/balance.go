@@ -0,0 +1,84 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// DayBalance es el saldo de horas de un día según el calendario laboral
+type DayBalance struct {
+	Date     string
+	Worked   float64
+	Expected float64
+}
+
+// Balance devuelve las horas trabajadas menos las esperadas
+func (d DayBalance) Balance() float64 {
+	return d.Worked - d.Expected
+}
+
+// DailyBalance calcula el saldo de cada día entre from y to (inclusive).
+// tasks son las tareas trabajadas en el rango, con Date y Hours de cada día trabajado.
+func DailyBalance(tasks []workflow.Task, calendar *Calendar, from string, to string) ([]DayBalance, error) {
+	if _, err := time.Parse("2006-01-02", from); err != nil {
+		return nil, fmt.Errorf("invalid date: %s (expected format: YYYY-MM-DD)", from)
+	}
+	if _, err := time.Parse("2006-01-02", to); err != nil {
+		return nil, fmt.Errorf("invalid date: %s (expected format: YYYY-MM-DD)", to)
+	}
+
+	worked := make(map[string]float64)
+	for _, task := range tasks {
+		worked[task.Date] += task.Hours
+	}
+
+	var days []DayBalance
+	var err error
+	forEachDay(from, to, func(date string) {
+		if err != nil {
+			return
+		}
+		var expected float64
+		if expected, err = calendar.ExpectedHours(date); err == nil {
+			days = append(days, DayBalance{Date: date, Worked: worked[date], Expected: expected})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return days, nil
+}
+
+// SumBalance suma las horas trabajadas y esperadas de varios días; Date es la del primero
+func SumBalance(days []DayBalance) DayBalance {
+	var total DayBalance
+	for _, day := range days {
+		total.Worked += day.Worked
+		total.Expected += day.Expected
+	}
+	if len(days) > 0 {
+		total.Date = days[0].Date
+	}
+	return total
+}
+
+// GetBalanceStart devuelve la fecha desde la que se acumula el saldo de horas, o "" si no está configurada
+func (cm *ConfigManager) GetBalanceStart() string {
+	return cm.config.BalanceStart
+}
+
+// SetBalanceStart guarda la fecha desde la que se acumula el saldo de horas
+func (cm *ConfigManager) SetBalanceStart(date string) error {
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return fmt.Errorf("invalid date format: %s (use YYYY-MM-DD)", date)
+	}
+
+	cm.config.BalanceStart = date
+	if err := cm.Save(); err != nil {
+		return fmt.Errorf("could not save config: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// TestDailyBalance comprueba el saldo de cada día de una semana con un feriado y horas en fin de semana
+func TestDailyBalance(t *testing.T) {
+	calendar := testCalendar(t)
+	tasks := []workflow.Task{
+		{ID: 1, Date: "2025-07-21", Hours: 5},
+		{ID: 2, Date: "2025-07-21", Hours: 4},
+		{ID: 1, Date: "2025-07-23", Hours: 1},
+		{ID: 3, Date: "2025-07-26", Hours: 2},
+		{ID: 4, Date: "2025-07-28", Hours: 8},
+	}
+
+	days, err := DailyBalance(tasks, calendar, "2025-07-21", "2025-07-27")
+	if err != nil {
+		t.Fatalf("DailyBalance error: %v", err)
+	}
+
+	want := []struct {
+		date     string
+		worked   float64
+		expected float64
+		balance  float64
+	}{
+		{date: "2025-07-21", worked: 9, expected: 8, balance: 1},
+		{date: "2025-07-22", worked: 0, expected: 8, balance: -8},
+		{date: "2025-07-23", worked: 1, expected: 0, balance: 1},
+		{date: "2025-07-24", worked: 0, expected: 8, balance: -8},
+		{date: "2025-07-25", worked: 0, expected: 6, balance: -6},
+		{date: "2025-07-26", worked: 2, expected: 0, balance: 2},
+		{date: "2025-07-27", worked: 0, expected: 0, balance: 0},
+	}
+	if len(days) != len(want) {
+		t.Fatalf("DailyBalance returned %d days, want %d", len(days), len(want))
+	}
+	for i, tt := range want {
+		day := days[i]
+		if day.Date != tt.date || day.Worked != tt.worked || day.Expected != tt.expected || day.Balance() != tt.balance {
+			t.Errorf("day %d = %+v (balance %v), want %s worked %v expected %v balance %v",
+				i, day, day.Balance(), tt.date, tt.worked, tt.expected, tt.balance)
+		}
+	}
+
+	total := SumBalance(days)
+	if total.Date != "2025-07-21" || total.Worked != 12 || total.Expected != 30 || total.Balance() != -18 {
+		t.Errorf("SumBalance = %+v, want 2025-07-21 worked 12 expected 30", total)
+	}
+}
+
+// TestDailyBalanceInvalidRange comprueba que se rechacen las fechas inválidas
+func TestDailyBalanceInvalidRange(t *testing.T) {
+	calendar := testCalendar(t)
+
+	tests := []struct {
+		from string
+		to   string
+	}{
+		{from: "2025-07-xx", to: "2025-07-27"},
+		{from: "2025-07-21", to: ""},
+	}
+
+	for _, tt := range tests {
+		if _, err := DailyBalance(nil, calendar, tt.from, tt.to); err == nil {
+			t.Errorf("DailyBalance(%q, %q) should fail", tt.from, tt.to)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// ApplyBatch aplica todos los cambios del lote en una única transacción
+func (dm *DatabaseManager) ApplyBatch(batch TaskBatch) error {
+	return dm.withTx(func(tx *sql.Tx) error {
+		for _, task := range batch.Create {
+			if err := insertTask(tx, task); err != nil {
+				return err
+			}
+		}
+
+		for _, task := range batch.Update {
+			if err := updateTask(tx, task); err != nil {
+				return err
+			}
+		}
+
+		for _, id := range batch.Delete {
+			if err := setTaskTrashed(tx, id, true); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ApplyBatch aplica todos los cambios del lote en una única transacción
+// y pausa o reanuda el temporizador si cambió el estado de su tarea
+func (tm *TaskManagerSQLite) ApplyBatch(batch TaskBatch) error {
+	if err := tm.dbManager.ApplyBatch(batch); err != nil {
+		return err
+	}
+
+	for _, task := range batch.Update {
+		if err := tm.syncTimerWithStatus(task.ID, task.Status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyBatch aplica todos los cambios del lote y guarda el archivo una sola vez
+func (tm *TaskManager) ApplyBatch(batch TaskBatch) error {
+	tasks, err := tm.LoadTasks()
+	if err != nil {
+		return fmt.Errorf("could not load tasks: %v", err)
+	}
+
+	index := make(map[int]int)
+	for i, task := range tasks {
+		if !task.InTrash() {
+			index[task.ID] = i
+		}
+	}
+
+	for _, task := range batch.Update {
+		i, exists := index[task.ID]
+		if !exists {
+			return fmt.Errorf("task with ID %d not found", task.ID)
+		}
+		tasks[i] = *task
+	}
+
+	for _, id := range batch.Delete {
+		i, exists := index[id]
+		if !exists {
+			return fmt.Errorf("task with ID %d not found", id)
+		}
+		tasks[i].DeletedAt = time.Now().UTC()
+	}
+
+	for _, task := range batch.Create {
+		task.ID = nextTaskID(tasks)
+		tasks = append(tasks, *task)
+	}
+
+	if err := tm.SaveTasks(tasks); err != nil {
+		return fmt.Errorf("could not save tasks: %v", err)
+	}
+
+	return nil
+}
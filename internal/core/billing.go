@@ -0,0 +1,224 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// Alcances a los que se puede asignar una tarifa por hora
+const (
+	RateDefault  = "default"
+	RateProject  = "project"
+	RateClient   = "client"
+	RateCategory = "category"
+)
+
+// InvoiceLine agrupa las horas facturables de un proyecto y categoría con su tarifa
+type InvoiceLine struct {
+	Project  string  `json:"project"`
+	Category string  `json:"category"`
+	Tasks    int     `json:"tasks"`
+	Hours    float64 `json:"hours"`
+	Rate     float64 `json:"rate"`
+	Amount   float64 `json:"amount"`
+}
+
+// Invoice es el resumen valorizado de las horas facturables de un período
+type Invoice struct {
+	Client           string        `json:"client,omitempty"`
+	From             string        `json:"from"`
+	To               string        `json:"to"`
+	Currency         string        `json:"currency"`
+	Rounding         int           `json:"rounding_minutes"`
+	RoundingMode     string        `json:"rounding_mode"`
+	Lines            []InvoiceLine `json:"lines"`
+	Hours            float64       `json:"hours"`
+	Total            float64       `json:"total"`
+	NonBillableHours float64       `json:"non_billable_hours"`
+}
+
+// NewInvoice agrupa las tareas facturables por proyecto y categoría.
+// Las horas de cada tarea se redondean según billing antes de sumarlas.
+func NewInvoice(tasks []workflow.Task, billing workflow.Billing, categories []workflow.Category) Invoice {
+	invoice := Invoice{
+		Currency:     billing.Currency,
+		Rounding:     billing.Rounding,
+		RoundingMode: billing.RoundingMode,
+		Lines:        []InvoiceLine{},
+	}
+
+	lines := make(map[[2]string]*InvoiceLine)
+	for _, task := range tasks {
+		if !IsBillable(task, categories) {
+			invoice.NonBillableHours += task.Hours
+			continue
+		}
+
+		key := [2]string{task.Project, task.Category}
+		line, exists := lines[key]
+		if !exists {
+			line = &InvoiceLine{Project: task.Project, Category: task.Category, Rate: RateFor(billing, task)}
+			lines[key] = line
+		}
+		line.Tasks++
+		line.Hours += RoundHours(task.Hours, billing.Rounding, billing.RoundingMode)
+	}
+
+	for _, line := range lines {
+		line.Hours = roundCents(line.Hours)
+		line.Amount = roundCents(line.Hours * line.Rate)
+		invoice.Lines = append(invoice.Lines, *line)
+		invoice.Hours += line.Hours
+		invoice.Total += line.Amount
+	}
+	sort.Slice(invoice.Lines, func(i, j int) bool {
+		if invoice.Lines[i].Project != invoice.Lines[j].Project {
+			return invoice.Lines[i].Project < invoice.Lines[j].Project
+		}
+		return invoice.Lines[i].Category < invoice.Lines[j].Category
+	})
+
+	invoice.Hours = roundCents(invoice.Hours)
+	invoice.Total = roundCents(invoice.Total)
+	invoice.NonBillableHours = roundCents(invoice.NonBillableHours)
+	return invoice
+}
+
+// IsBillable indica si una tarea se factura: su propio valor o, si no lo tiene, el de su categoría.
+// Las tareas de categorías desconocidas se facturan.
+func IsBillable(task workflow.Task, categories []workflow.Category) bool {
+	if task.Billable != nil {
+		return *task.Billable
+	}
+	for _, category := range categories {
+		if category.Name == task.Category {
+			return category.Billable
+		}
+	}
+	return true
+}
+
+// RateFor devuelve la tarifa por hora de una tarea: la de su proyecto, cliente, categoría o la tarifa por defecto
+func RateFor(billing workflow.Billing, task workflow.Task) float64 {
+	if rate, exists := billing.ProjectRates[task.Project]; exists && task.Project != "" {
+		return rate
+	}
+	if rate, exists := billing.ClientRates[task.Client]; exists && task.Client != "" {
+		return rate
+	}
+	if rate, exists := billing.CategoryRates[task.Category]; exists {
+		return rate
+	}
+	return billing.DefaultRate
+}
+
+// GetBilling devuelve la configuración de tarifas y redondeo de facturación
+func (cm *ConfigManager) GetBilling() workflow.Billing {
+	return cm.config.Billing
+}
+
+// SetRate guarda la tarifa por hora de un alcance; name se ignora para la tarifa por defecto
+func (cm *ConfigManager) SetRate(scope string, name string, rate float64) error {
+	if rate < 0 {
+		return fmt.Errorf("rate cannot be negative")
+	}
+	if scope == RateDefault {
+		cm.config.Billing.DefaultRate = rate
+		return cm.saveBilling()
+	}
+
+	rates, err := cm.scopeRates(scope, name)
+	if err != nil {
+		return err
+	}
+	if *rates == nil {
+		*rates = make(map[string]float64)
+	}
+	(*rates)[name] = rate
+	return cm.saveBilling()
+}
+
+// RemoveRate quita la tarifa propia de un proyecto, cliente o categoría
+func (cm *ConfigManager) RemoveRate(scope string, name string) error {
+	if scope == RateDefault {
+		return fmt.Errorf("the default rate cannot be removed, set it to 0 instead")
+	}
+
+	rates, err := cm.scopeRates(scope, name)
+	if err != nil {
+		return err
+	}
+	if _, exists := (*rates)[name]; !exists {
+		return fmt.Errorf("no %s rate set for '%s'", scope, name)
+	}
+	delete(*rates, name)
+	return cm.saveBilling()
+}
+
+// SetCurrency guarda el código de moneda de las facturas
+func (cm *ConfigManager) SetCurrency(currency string) error {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		return fmt.Errorf("currency cannot be empty")
+	}
+
+	cm.config.Billing.Currency = currency
+	return cm.saveBilling()
+}
+
+// SetBillingRounding guarda el incremento en minutos y el modo de redondeo de las horas facturadas
+func (cm *ConfigManager) SetBillingRounding(minutes int, mode string) error {
+	if err := ValidateRounding(minutes, mode); err != nil {
+		return err
+	}
+
+	cm.config.Billing.Rounding = minutes
+	cm.config.Billing.RoundingMode = mode
+	return cm.saveBilling()
+}
+
+// ValidateRounding comprueba un incremento en minutos y un modo de redondeo
+func ValidateRounding(minutes int, mode string) error {
+	if minutes < 0 || minutes > 60 {
+		return fmt.Errorf("rounding must be between 0 and 60 minutes")
+	}
+	switch mode {
+	case workflow.RoundNearest, workflow.RoundUp, workflow.RoundDown:
+		return nil
+	}
+	return fmt.Errorf("invalid rounding mode '%s' (use %s, %s or %s)", mode, workflow.RoundNearest, workflow.RoundUp, workflow.RoundDown)
+}
+
+// scopeRates devuelve el mapa de tarifas de un alcance
+func (cm *ConfigManager) scopeRates(scope string, name string) (*map[string]float64, error) {
+	if name == "" {
+		return nil, fmt.Errorf("a %s name is required", scope)
+	}
+
+	switch scope {
+	case RateProject:
+		return &cm.config.Billing.ProjectRates, nil
+	case RateClient:
+		return &cm.config.Billing.ClientRates, nil
+	case RateCategory:
+		return &cm.config.Billing.CategoryRates, nil
+	}
+	return nil, fmt.Errorf("invalid rate scope '%s'", scope)
+}
+
+// saveBilling guarda la configuración tras cambiar la facturación
+func (cm *ConfigManager) saveBilling() error {
+	if err := cm.Save(); err != nil {
+		return fmt.Errorf("could not save config: %v", err)
+	}
+	return nil
+}
+
+// roundCents redondea un importe u horas a dos decimales
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,315 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// Calendar es el calendario laboral ya interpretado
+type Calendar struct {
+	weekStart    time.Weekday
+	workingDays  map[time.Weekday]bool
+	dailyHours   map[time.Weekday]float64
+	defaultHours float64
+	holidays     map[string]string
+	leave        map[string]float64 // horas de ausencia por fecha; cero es el día completo
+}
+
+// NewCalendar interpreta el calendario laboral de la configuración.
+// Los días laborables sin horas propias esperan defaultHours.
+func NewCalendar(config workflow.WorkCalendar, defaultHours float64) (*Calendar, error) {
+	calendar := &Calendar{
+		workingDays:  make(map[time.Weekday]bool),
+		dailyHours:   make(map[time.Weekday]float64),
+		defaultHours: defaultHours,
+		holidays:     make(map[string]string),
+	}
+
+	weekStart, err := ParseWeekday(config.WeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("invalid calendar week start: %v", err)
+	}
+	calendar.weekStart = weekStart
+
+	for _, name := range config.WorkingDays {
+		weekday, err := ParseWeekday(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar working day: %v", err)
+		}
+		calendar.workingDays[weekday] = true
+	}
+
+	for name, hours := range config.DailyHours {
+		weekday, err := ParseWeekday(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid calendar daily hours: %v", err)
+		}
+		if hours < 0 || hours > 24 {
+			return nil, fmt.Errorf("invalid calendar daily hours for %s: %.2f (must be between 0 and 24)", name, hours)
+		}
+		calendar.dailyHours[weekday] = hours
+	}
+
+	for _, holiday := range config.Holidays {
+		if _, err := time.Parse("2006-01-02", holiday.Date); err != nil {
+			return nil, fmt.Errorf("invalid holiday date: %s (expected format: YYYY-MM-DD)", holiday.Date)
+		}
+		calendar.holidays[holiday.Date] = holiday.Name
+	}
+
+	return calendar, nil
+}
+
+// ParseWeekday interpreta el nombre en inglés de un día de la semana ("monday" o "mon")
+func ParseWeekday(name string) (time.Weekday, error) {
+	weekday, exists := weekdayNames[strings.ToLower(strings.TrimSpace(name))]
+	if !exists {
+		return 0, fmt.Errorf("unknown weekday '%s'", name)
+	}
+	return weekday, nil
+}
+
+// WeekStart devuelve el primer día de la semana laboral
+func (c *Calendar) WeekStart() time.Weekday {
+	return c.weekStart
+}
+
+// StartOfWeek devuelve el primer día de la semana que contiene t
+func (c *Calendar) StartOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()-c.weekStart) + 7) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+// IsWorkingDay indica si un día de la semana es laborable
+func (c *Calendar) IsWorkingDay(weekday time.Weekday) bool {
+	return c.workingDays[weekday]
+}
+
+// HoursFor devuelve las horas esperadas un día laborable de la semana, sin contar feriados
+func (c *Calendar) HoursFor(weekday time.Weekday) float64 {
+	if !c.workingDays[weekday] {
+		return 0
+	}
+	if hours, exists := c.dailyHours[weekday]; exists {
+		return hours
+	}
+	return c.defaultHours
+}
+
+// Holiday devuelve el nombre del feriado de una fecha (YYYY-MM-DD), si lo es
+func (c *Calendar) Holiday(date string) (string, bool) {
+	name, exists := c.holidays[date]
+	return name, exists
+}
+
+// IsBusinessDay indica si una fecha es un día laborable que no es feriado
+func (c *Calendar) IsBusinessDay(date time.Time) bool {
+	if _, holiday := c.holidays[date.Format("2006-01-02")]; holiday {
+		return false
+	}
+	return c.workingDays[date.Weekday()]
+}
+
+// ExpectedHours devuelve las horas esperadas en una fecha (YYYY-MM-DD), descontando las ausencias
+func (c *Calendar) ExpectedHours(date string) (float64, error) {
+	scheduled, err := c.scheduledHours(date)
+	if err != nil {
+		return 0, err
+	}
+	return scheduled - c.leaveHours(date, scheduled), nil
+}
+
+// LeaveHours devuelve las horas esperadas de una fecha que quedan cubiertas por ausencias
+func (c *Calendar) LeaveHours(date string) (float64, error) {
+	scheduled, err := c.scheduledHours(date)
+	if err != nil {
+		return 0, err
+	}
+	return c.leaveHours(date, scheduled), nil
+}
+
+// scheduledHours devuelve las horas de trabajo de una fecha según la semana laboral y los feriados
+func (c *Calendar) scheduledHours(date string) (float64, error) {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date: %s (expected format: YYYY-MM-DD)", date)
+	}
+	if _, holiday := c.holidays[date]; holiday {
+		return 0, nil
+	}
+	return c.HoursFor(t.Weekday()), nil
+}
+
+// leaveHours devuelve la parte de las horas de trabajo de una fecha cubierta por ausencias
+func (c *Calendar) leaveHours(date string, scheduled float64) float64 {
+	hours, onLeave := c.leave[date]
+	if !onLeave {
+		return 0
+	}
+	if hours == 0 {
+		return scheduled
+	}
+	return min(hours, scheduled)
+}
+
+// WithLeave devuelve una copia del calendario que descuenta las ausencias indicadas
+func (c *Calendar) WithLeave(leaves []workflow.Leave) *Calendar {
+	withLeave := *c
+	withLeave.leave = make(map[string]float64, len(c.leave))
+	for date, hours := range c.leave {
+		withLeave.leave[date] = hours
+	}
+
+	for _, leave := range leaves {
+		forEachDay(leave.From, leave.To, func(date string) {
+			current, exists := withLeave.leave[date]
+			switch {
+			case !exists:
+				withLeave.leave[date] = leave.Hours
+			case current == 0 || leave.Hours == 0:
+				// Una ausencia de día completo cubre cualquier otra
+				withLeave.leave[date] = 0
+			default:
+				withLeave.leave[date] = current + leave.Hours
+			}
+		})
+	}
+
+	return &withLeave
+}
+
+// LeaveDays cuenta los días laborables de una ausencia dentro del rango indicado.
+// Las ausencias parciales cuentan como la fracción de la jornada que cubren.
+func (c *Calendar) LeaveDays(leave workflow.Leave, from string, to string) float64 {
+	days := 0.0
+	forEachDay(max(leave.From, from), min(leave.To, to), func(date string) {
+		scheduled, err := c.scheduledHours(date)
+		if err != nil || scheduled == 0 {
+			return
+		}
+		if leave.Hours == 0 {
+			days++
+			return
+		}
+		days += min(leave.Hours, scheduled) / scheduled
+	})
+	return days
+}
+
+// forEachDay recorre las fechas entre from y to, ambas inclusive; no hace nada si alguna no es válida
+func forEachDay(from string, to string, fn func(date string)) {
+	start, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return
+	}
+	end, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return
+	}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		fn(day.Format("2006-01-02"))
+	}
+}
+
+// ExpectedHoursInRange suma las horas esperadas entre dos fechas, ambas inclusive
+func (c *Calendar) ExpectedHoursInRange(from string, to string) (float64, error) {
+	start, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date: %s (expected format: YYYY-MM-DD)", from)
+	}
+	end, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date: %s (expected format: YYYY-MM-DD)", to)
+	}
+
+	total := 0.0
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		hours, err := c.ExpectedHours(day.Format("2006-01-02"))
+		if err != nil {
+			return 0, err
+		}
+		total += hours
+	}
+	return total, nil
+}
+
+// GetCalendar devuelve el calendario laboral configurado
+func (cm *ConfigManager) GetCalendar() (*Calendar, error) {
+	return NewCalendar(cm.config.Calendar, cm.config.DailyHoursTarget)
+}
+
+// SetCalendar valida y guarda un calendario laboral nuevo
+func (cm *ConfigManager) SetCalendar(calendar workflow.WorkCalendar) error {
+	if _, err := NewCalendar(calendar, cm.config.DailyHoursTarget); err != nil {
+		return err
+	}
+
+	cm.config.Calendar = calendar
+	if err := cm.Save(); err != nil {
+		return fmt.Errorf("could not save config: %v", err)
+	}
+	return nil
+}
+
+// ImportHolidays agrega feriados al calendario; un feriado en una fecha existente reemplaza al anterior.
+// Con replace se descartan antes todos los feriados guardados. Devuelve cuántas fechas se agregaron o cambiaron.
+func (cm *ConfigManager) ImportHolidays(holidays []workflow.Holiday, replace bool) (int, error) {
+	calendar := cm.config.Calendar
+
+	byDate := make(map[string]string)
+	if !replace {
+		for _, holiday := range calendar.Holidays {
+			byDate[holiday.Date] = holiday.Name
+		}
+	}
+
+	changed := 0
+	for _, holiday := range holidays {
+		if name, exists := byDate[holiday.Date]; !exists || name != holiday.Name {
+			changed++
+		}
+		byDate[holiday.Date] = holiday.Name
+	}
+
+	calendar.Holidays = make([]workflow.Holiday, 0, len(byDate))
+	for date, name := range byDate {
+		calendar.Holidays = append(calendar.Holidays, workflow.Holiday{Date: date, Name: name})
+	}
+	sort.Slice(calendar.Holidays, func(i, j int) bool { return calendar.Holidays[i].Date < calendar.Holidays[j].Date })
+
+	if err := cm.SetCalendar(calendar); err != nil {
+		return 0, err
+	}
+	return changed, nil
+}
+
+// HolidaysFromEvents convierte los eventos de un archivo iCalendar en feriados.
+// Los eventos de día completo de varios días generan un feriado por día.
+func HolidaysFromEvents(events []ICalEvent) []workflow.Holiday {
+	var holidays []workflow.Holiday
+	for _, event := range events {
+		name := event.Summary
+		if name == "" {
+			name = "Holiday"
+		}
+
+		if !event.AllDay || !event.End.After(event.Start) {
+			date := DateOf(event.Start)
+			if event.AllDay {
+				date = event.Start.Format("2006-01-02")
+			}
+			holidays = append(holidays, workflow.Holiday{Date: date, Name: name})
+			continue
+		}
+
+		// En los eventos de día completo DTEND es exclusivo
+		for day := event.Start; day.Before(event.End); day = day.AddDate(0, 0, 1) {
+			holidays = append(holidays, workflow.Holiday{Date: day.Format("2006-01-02"), Name: name})
+		}
+	}
+	return holidays
+}
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// testCalendar devuelve una semana de lunes a viernes de 8h, con viernes de 6h y un feriado el miércoles 23 de julio de 2025
+func testCalendar(t *testing.T) *Calendar {
+	t.Helper()
+	calendar, err := NewCalendar(workflow.WorkCalendar{
+		WeekStart:   "monday",
+		WorkingDays: []string{"monday", "tuesday", "wednesday", "thursday", "fri"},
+		DailyHours:  map[string]float64{"friday": 6},
+		Holidays:    []workflow.Holiday{{Date: "2025-07-23", Name: "Holiday"}},
+	}, 8)
+	if err != nil {
+		t.Fatalf("NewCalendar error: %v", err)
+	}
+	return calendar
+}
+
+// TestCalendarExpectedHours comprueba las horas esperadas con feriados, horas por día y ausencias
+func TestCalendarExpectedHours(t *testing.T) {
+	calendar := testCalendar(t)
+	withLeave := calendar.WithLeave([]workflow.Leave{
+		{From: "2025-07-21", To: "2025-07-21", Hours: 10},
+		{From: "2025-07-22", To: "2025-07-22", Hours: 3},
+		{From: "2025-07-22", To: "2025-07-22", Hours: 3},
+		{From: "2025-07-24", To: "2025-07-25", Hours: 2},
+		{From: "2025-07-24", To: "2025-07-24"},
+	})
+
+	tests := []struct {
+		date      string
+		want      float64
+		scheduled float64
+	}{
+		{date: "2025-07-21", want: 0, scheduled: 8},
+		{date: "2025-07-22", want: 2, scheduled: 8},
+		{date: "2025-07-23", want: 0, scheduled: 0},
+		{date: "2025-07-24", want: 0, scheduled: 8},
+		{date: "2025-07-25", want: 4, scheduled: 6},
+		{date: "2025-07-26", want: 0, scheduled: 0},
+		{date: "2025-07-28", want: 8, scheduled: 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			got, err := withLeave.ExpectedHours(tt.date)
+			if err != nil {
+				t.Fatalf("ExpectedHours(%s) error: %v", tt.date, err)
+			}
+			if got != tt.want {
+				t.Errorf("ExpectedHours(%s) with leave = %v, want %v", tt.date, got, tt.want)
+			}
+
+			got, err = calendar.ExpectedHours(tt.date)
+			if err != nil {
+				t.Fatalf("ExpectedHours(%s) error: %v", tt.date, err)
+			}
+			if got != tt.scheduled {
+				t.Errorf("ExpectedHours(%s) without leave = %v, want %v", tt.date, got, tt.scheduled)
+			}
+		})
+	}
+
+	if _, err := calendar.ExpectedHours("2025-7-1"); err == nil {
+		t.Errorf("ExpectedHours should fail for an invalid date")
+	}
+}
+
+// TestCalendarExpectedHoursInRange comprueba la suma de una semana con y sin ausencias
+func TestCalendarExpectedHoursInRange(t *testing.T) {
+	calendar := testCalendar(t)
+
+	tests := []struct {
+		name     string
+		calendar *Calendar
+		want     float64
+	}{
+		{name: "without leave", calendar: calendar, want: 30},
+		{name: "with leave", calendar: calendar.WithLeave([]workflow.Leave{{From: "2025-07-20", To: "2025-07-22"}}), want: 14},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.calendar.ExpectedHoursInRange("2025-07-21", "2025-07-27")
+			if err != nil {
+				t.Fatalf("ExpectedHoursInRange error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ExpectedHoursInRange = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewCalendarInvalid comprueba que se rechacen las configuraciones inválidas
+func TestNewCalendarInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		config workflow.WorkCalendar
+	}{
+		{name: "week start", config: workflow.WorkCalendar{WeekStart: "someday"}},
+		{name: "working day", config: workflow.WorkCalendar{WeekStart: "monday", WorkingDays: []string{"funday"}}},
+		{name: "daily hours", config: workflow.WorkCalendar{WeekStart: "monday", DailyHours: map[string]float64{"monday": 25}}},
+		{name: "holiday", config: workflow.WorkCalendar{WeekStart: "monday", Holidays: []workflow.Holiday{{Date: "25/12/2025"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewCalendar(tt.config, 8); err == nil {
+				t.Errorf("NewCalendar should fail for an invalid %s", tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,326 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// namedColors son los colores aceptados por nombre para una categoría
+var namedColors = map[string]bool{
+	"black": true, "red": true, "green": true, "yellow": true,
+	"blue": true, "magenta": true, "cyan": true, "white": true,
+}
+
+// hexColorPattern valida colores en formato #rrggbb
+var hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// GetCategories devuelve las categorías configuradas
+func (cm *ConfigManager) GetCategories() []workflow.Category {
+	return cm.config.Categories
+}
+
+// FindCategory busca una categoría por nombre
+func (cm *ConfigManager) FindCategory(name string) (*workflow.Category, bool) {
+	for i := range cm.config.Categories {
+		if cm.config.Categories[i].Name == name {
+			return &cm.config.Categories[i], true
+		}
+	}
+	return nil, false
+}
+
+// ValidateCategory comprueba que una categoría exista y sugiere nombres parecidos si no
+func (cm *ConfigManager) ValidateCategory(name string) error {
+	if _, exists := cm.FindCategory(name); exists {
+		return nil
+	}
+
+	suggestions := SuggestCategories(name, cm.config.Categories)
+	if len(suggestions) > 0 {
+		return fmt.Errorf("unknown category '%s'. Did you mean: %s?", name, strings.Join(suggestions, ", "))
+	}
+	return fmt.Errorf("unknown category '%s' (see 'workflow category list')", name)
+}
+
+// AddCategory agrega una categoría nueva a la configuración
+func (cm *ConfigManager) AddCategory(category workflow.Category) error {
+	category.Name = strings.TrimSpace(category.Name)
+	if err := validateCategoryName(category.Name); err != nil {
+		return err
+	}
+	if _, exists := cm.FindCategory(category.Name); exists {
+		return fmt.Errorf("category '%s' already exists", category.Name)
+	}
+	if category.Color != "" && !isValidColor(category.Color) {
+		return fmt.Errorf("invalid color '%s': use a named color (%s) or #rrggbb", category.Color, strings.Join(colorNames(), ", "))
+	}
+	if category.DefaultHours < 0 {
+		return fmt.Errorf("default hours cannot be negative")
+	}
+	if category.Icon == "" {
+		category.Icon = workflow.DefaultIcon
+	}
+
+	cm.config.Categories = append(cm.config.Categories, category)
+	return cm.saveCategories()
+}
+
+// RemoveCategory quita una categoría de la configuración.
+// La categoría por defecto no se puede quitar porque la usan las tareas agregadas sin categoría.
+func (cm *ConfigManager) RemoveCategory(name string) error {
+	if name == workflow.DefaultCategory {
+		return fmt.Errorf("category '%s' is the default category and cannot be removed", name)
+	}
+	for i, category := range cm.config.Categories {
+		if category.Name == name {
+			cm.config.Categories = append(cm.config.Categories[:i], cm.config.Categories[i+1:]...)
+			return cm.saveCategories()
+		}
+	}
+	return cm.ValidateCategory(name)
+}
+
+// RenameCategory cambia el nombre de una categoría en la configuración, con su tarifa y sus atajos
+func (cm *ConfigManager) RenameCategory(oldName string, newName string) error {
+	newName = strings.TrimSpace(newName)
+	if err := validateCategoryName(newName); err != nil {
+		return err
+	}
+
+	if oldName == workflow.DefaultCategory {
+		return fmt.Errorf("category '%s' is the default category and cannot be renamed", oldName)
+	}
+
+	category, exists := cm.FindCategory(oldName)
+	if !exists {
+		return cm.ValidateCategory(oldName)
+	}
+	if _, taken := cm.FindCategory(newName); taken {
+		return fmt.Errorf("category '%s' already exists", newName)
+	}
+
+	category.Name = newName
+
+	if rate, exists := cm.config.Billing.CategoryRates[oldName]; exists {
+		delete(cm.config.Billing.CategoryRates, oldName)
+		cm.config.Billing.CategoryRates[newName] = rate
+	}
+	for i := range cm.config.Shortcuts {
+		if cm.config.Shortcuts[i].Category == oldName {
+			cm.config.Shortcuts[i].Category = newName
+		}
+	}
+
+	return cm.saveCategories()
+}
+
+// saveCategories guarda la configuración y actualiza los iconos registrados
+func (cm *ConfigManager) saveCategories() error {
+	if err := cm.Save(); err != nil {
+		return fmt.Errorf("could not save config: %v", err)
+	}
+	workflow.RegisterCategories(cm.config.Categories)
+	return nil
+}
+
+// validateCategoryName comprueba que un nombre de categoría sea utilizable desde la línea de comandos
+func validateCategoryName(name string) error {
+	if name == "" {
+		return fmt.Errorf("category name cannot be empty")
+	}
+	if strings.ContainsAny(name, " ,") {
+		return fmt.Errorf("invalid category '%s': names cannot contain spaces or commas", name)
+	}
+	return nil
+}
+
+// isValidColor indica si un color es un nombre conocido o un valor #rrggbb
+func isValidColor(color string) bool {
+	return namedColors[strings.ToLower(color)] || hexColorPattern.MatchString(color)
+}
+
+// colorNames devuelve los nombres de colores aceptados ordenados
+func colorNames() []string {
+	names := make([]string, 0, len(namedColors))
+	for name := range namedColors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SuggestCategories devuelve las categorías cuyo nombre se parece al indicado
+func SuggestCategories(name string, categories []workflow.Category) []string {
+	name = strings.ToLower(name)
+	var suggestions []string
+
+	for _, category := range categories {
+		candidate := strings.ToLower(category.Name)
+		if levenshtein(name, candidate) <= 2 || (len(name) >= 2 && strings.HasPrefix(candidate, name)) {
+			suggestions = append(suggestions, category.Name)
+		}
+	}
+
+	return suggestions
+}
+
+// levenshtein calcula la distancia de edición entre dos cadenas
+func levenshtein(a string, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+// CountTasksInCategory cuenta las tareas guardadas con una categoría
+func (dm *DatabaseManager) CountTasksInCategory(category string) (int, error) {
+	var count int
+	if err := dm.db.QueryRow(`SELECT COUNT(*) FROM tasks WHERE category = ?`, category).Scan(&count); err != nil {
+		return 0, fmt.Errorf("could not count tasks: %v", err)
+	}
+	return count, nil
+}
+
+// RenameTaskCategory reescribe la categoría de las tareas y plantillas recurrentes que la usan en una transacción.
+// Cada tarea se actualiza como una edición, con su entrada en el historial para poder deshacerla.
+func (dm *DatabaseManager) RenameTaskCategory(oldName string, newName string) (int, error) {
+	renamed := 0
+	err := dm.withTx(func(tx *sql.Tx) error {
+		rows, err := tx.Query(`SELECT id FROM tasks WHERE category = ? ORDER BY id`, oldName)
+		if err != nil {
+			return fmt.Errorf("could not query tasks: %v", err)
+		}
+		var ids []int
+		for rows.Next() {
+			var id int
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return fmt.Errorf("could not scan task id: %v", err)
+			}
+			ids = append(ids, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("could not query tasks: %v", err)
+		}
+
+		for _, id := range ids {
+			task, err := getTaskTx(tx, id)
+			if err != nil {
+				return err
+			}
+			task.Category = newName
+			if err := updateTask(tx, task); err != nil {
+				return err
+			}
+		}
+
+		if _, err := tx.Exec(`UPDATE recurring_templates SET category = ? WHERE category = ?`, newName, oldName); err != nil {
+			return fmt.Errorf("could not update recurring templates: %v", err)
+		}
+
+		renamed = len(ids)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not rename task category: %v", err)
+	}
+
+	return renamed, nil
+}
+
+// ValidateCategory comprueba que la categoría esté configurada
+func (tm *TaskManagerSQLite) ValidateCategory(name string) error {
+	return tm.configManager.ValidateCategory(name)
+}
+
+// CountTasksInCategory cuenta las tareas que usan una categoría
+func (tm *TaskManagerSQLite) CountTasksInCategory(category string) (int, error) {
+	return tm.dbManager.CountTasksInCategory(category)
+}
+
+// RenameCategory renombra una categoría en la configuración y en las tareas existentes
+func (tm *TaskManagerSQLite) RenameCategory(oldName string, newName string) (int, error) {
+	if err := tm.configManager.RenameCategory(oldName, newName); err != nil {
+		return 0, err
+	}
+
+	renamed, err := tm.dbManager.RenameTaskCategory(oldName, newName)
+	if err != nil {
+		// Deshacer el cambio en la configuración para no dejar tareas huérfanas
+		tm.configManager.RenameCategory(newName, oldName)
+		return 0, err
+	}
+
+	return renamed, nil
+}
+
+// ValidateCategory comprueba que la categoría esté configurada
+func (tm *TaskManager) ValidateCategory(name string) error {
+	return tm.configManager.ValidateCategory(name)
+}
+
+// CountTasksInCategory cuenta las tareas que usan una categoría
+func (tm *TaskManager) CountTasksInCategory(category string) (int, error) {
+	tasks, err := tm.LoadTasks()
+	if err != nil {
+		return 0, fmt.Errorf("could not load tasks: %v", err)
+	}
+
+	count := 0
+	for _, task := range tasks {
+		if task.Category == category {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// RenameCategory renombra una categoría en la configuración y en las tareas existentes
+func (tm *TaskManager) RenameCategory(oldName string, newName string) (int, error) {
+	tasks, err := tm.LoadTasks()
+	if err != nil {
+		return 0, fmt.Errorf("could not load tasks: %v", err)
+	}
+
+	if err := tm.configManager.RenameCategory(oldName, newName); err != nil {
+		return 0, err
+	}
+
+	renamed := 0
+	for i := range tasks {
+		if tasks[i].Category == oldName {
+			tasks[i].Category = newName
+			renamed++
+		}
+	}
+
+	if err := tm.SaveTasks(tasks); err != nil {
+		tm.configManager.RenameCategory(newName, oldName)
+		return 0, fmt.Errorf("could not save tasks: %v", err)
+	}
+
+	return renamed, nil
+}
@@ -0,0 +1,28 @@
+package core
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// TestDefaultCategoryIsKept comprueba que la categoría por defecto no se pueda quitar ni renombrar
+func TestDefaultCategoryIsKept(t *testing.T) {
+	homeDir := t.TempDir()
+	cm := &ConfigManager{config: getDefaultConfig(homeDir), configPath: filepath.Join(homeDir, "config.json")}
+
+	if err := cm.RemoveCategory(workflow.DefaultCategory); err == nil {
+		t.Errorf("RemoveCategory(%q) should fail", workflow.DefaultCategory)
+	}
+	if err := cm.RenameCategory(workflow.DefaultCategory, "misc"); err == nil {
+		t.Errorf("RenameCategory(%q) should fail", workflow.DefaultCategory)
+	}
+	if err := cm.ValidateCategory(workflow.DefaultCategory); err != nil {
+		t.Errorf("default category is no longer configured: %v", err)
+	}
+
+	if err := cm.RemoveCategory("research"); err != nil {
+		t.Errorf("RemoveCategory(research) error: %v", err)
+	}
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"fmt"
+	"time"
+)
+
+// location es la zona horaria en la que se calculan "hoy" y los límites de semana y mes
+var location = time.Local
+
+// LoadTimezone interpreta un nombre de zona IANA como "America/Argentina/Buenos_Aires".
+// Un nombre vacío o "Local" usan la zona horaria del sistema.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return time.Local, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone '%s' (use an IANA name such as Europe/Madrid or America/New_York)", name)
+	}
+	return loc, nil
+}
+
+// SetTimezone fija la zona horaria usada por Now y Today
+func SetTimezone(name string) error {
+	loc, err := LoadTimezone(name)
+	if err != nil {
+		return err
+	}
+	location = loc
+	return nil
+}
+
+// Location devuelve la zona horaria en uso
+func Location() *time.Location {
+	return location
+}
+
+// Now devuelve la hora actual en la zona horaria configurada
+func Now() time.Time {
+	return time.Now().In(location)
+}
+
+// Today devuelve la fecha actual (YYYY-MM-DD) en la zona horaria configurada
+func Today() string {
+	return Now().Format("2006-01-02")
+}
+
+// DateOf devuelve la fecha (YYYY-MM-DD) de un instante en la zona horaria configurada
+func DateOf(t time.Time) string {
+	return t.In(location).Format("2006-01-02")
+}
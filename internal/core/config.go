@@ -33,7 +33,14 @@ func getDefaultConfig(homeDir string) *workflow.Config {
 		DataFile:          filepath.Join(homeDir, ".workflow", "tasks.json"),
 		UserName:          os.Getenv("USER"),
 		Company:           "",
-		Timezone:          "UTC",
+		Timezone:          "Local",
+		Backend:           BackendSQLite,
+		TimerRounding:     15,
+		TimerRoundingMode: workflow.RoundNearest,
+		Categories:        workflow.DefaultCategories(),
+		Shortcuts:         workflow.DefaultShortcuts(),
+		Calendar:          workflow.DefaultWorkCalendar(),
+		Billing:           workflow.DefaultBilling(),
 	}
 }
 
@@ -56,7 +63,46 @@ func (cm *ConfigManager) Load() error {
 	}
 	defer file.Close()
 
-	return json.NewDecoder(file).Decode(cm.config)
+	// Las categorías y atajos se decodifican desde cero para no mezclar campos con los de por defecto
+	cm.config.Categories = nil
+	cm.config.Shortcuts = nil
+	cm.config.Calendar = workflow.WorkCalendar{}
+	cm.config.Billing = workflow.Billing{}
+	if err := json.NewDecoder(file).Decode(cm.config); err != nil {
+		return err
+	}
+	file.Close()
+
+	if len(cm.config.Categories) == 0 {
+		cm.config.Categories = workflow.DefaultCategories()
+	}
+
+	// Una lista vacía explícita desactiva los comandos rápidos
+	if cm.config.Shortcuts == nil {
+		cm.config.Shortcuts = workflow.DefaultShortcuts()
+	}
+
+	// Completar el calendario laboral de configuraciones anteriores
+	defaultCalendar := workflow.DefaultWorkCalendar()
+	if cm.config.Calendar.WeekStart == "" {
+		cm.config.Calendar.WeekStart = defaultCalendar.WeekStart
+	}
+	if cm.config.Calendar.WorkingDays == nil {
+		cm.config.Calendar.WorkingDays = defaultCalendar.WorkingDays
+	}
+
+	// Completar la facturación de configuraciones anteriores
+	defaultBilling := workflow.DefaultBilling()
+	if cm.config.Billing.Currency == "" {
+		cm.config.Billing.Currency = defaultBilling.Currency
+	}
+	if cm.config.Billing.RoundingMode == "" {
+		cm.config.Billing.RoundingMode = defaultBilling.RoundingMode
+	}
+
+	// Registrar los iconos de las categorías configuradas
+	workflow.RegisterCategories(cm.config.Categories)
+	return nil
 }
 
 // Save guarda la configuración en el archivo
@@ -89,3 +135,13 @@ func (cm *ConfigManager) GetDailyHoursTarget() float64 {
 func (cm *ConfigManager) GetDailyStandupHours() float64 {
 	return cm.config.DailyStandupHours
 }
+
+// GetShortcuts devuelve los comandos rápidos configurados
+func (cm *ConfigManager) GetShortcuts() []workflow.Shortcut {
+	return cm.config.Shortcuts
+}
+
+// GetTimerRounding devuelve el incremento en minutos y el modo de redondeo de los temporizadores
+func (cm *ConfigManager) GetTimerRounding() (int, string) {
+	return cm.config.TimerRounding, cm.config.TimerRoundingMode
+}
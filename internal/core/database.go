@@ -5,12 +5,19 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// taskColumns son las columnas leídas por scanTask, en orden
+const taskColumns = `t.id, t.description, t.hours, t.category, t.date, t.status, t.created_at, COALESCE(p.name, ''), COALESCE(c.name, ''), ` + taskTagsColumn + `, t.billable, t.deleted_at`
+
+// taskTables une cada tarea con su proyecto y cliente; las columnas de tareas usan el alias t
+const taskTables = `tasks t LEFT JOIN projects p ON p.id = t.project_id LEFT JOIN clients c ON c.id = p.client_id`
+
 // DatabaseManager maneja las operaciones de la base de datos SQLite
 type DatabaseManager struct {
 	dbPath string
@@ -25,7 +32,7 @@ func NewDatabaseManager(dataDir string) *DatabaseManager {
 	}
 }
 
-// Init inicializa la base de datos y crea las tablas si no existen
+// Init inicializa la base de datos y aplica las migraciones pendientes
 func (dm *DatabaseManager) Init() error {
 	// Crear directorio si no existe
 	dir := filepath.Dir(dm.dbPath)
@@ -33,21 +40,27 @@ func (dm *DatabaseManager) Init() error {
 		return fmt.Errorf("could not create database directory: %v", err)
 	}
 
-	// Abrir conexión a la base de datos
-	db, err := sql.Open("sqlite3", dm.dbPath)
+	// Abrir conexión a la base de datos; las claves foráneas se activan en cada conexión
+	db, err := sql.Open("sqlite3", dm.dataSourceName())
 	if err != nil {
 		return fmt.Errorf("could not open database: %v", err)
 	}
 	dm.db = db
 
-	// Crear tabla si no existe
-	if err := dm.createTables(); err != nil {
-		return fmt.Errorf("could not create tables: %v", err)
+	// Aplicar migraciones pendientes
+	if err := dm.migrate(); err != nil {
+		return fmt.Errorf("could not migrate database: %v", err)
 	}
 
 	return nil
 }
 
+// dataSourceName devuelve la ruta de la base de datos con las opciones de conexión.
+// SQLite no aplica ON DELETE CASCADE ni SET NULL si no se activan las claves foráneas.
+func (dm *DatabaseManager) dataSourceName() string {
+	return dm.dbPath + "?_foreign_keys=on"
+}
+
 // Close cierra la conexión a la base de datos
 func (dm *DatabaseManager) Close() error {
 	if dm.db != nil {
@@ -56,33 +69,9 @@ func (dm *DatabaseManager) Close() error {
 	return nil
 }
 
-// createTables crea las tablas necesarias
-func (dm *DatabaseManager) createTables() error {
-	query := `
-	CREATE TABLE IF NOT EXISTS tasks (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		description TEXT NOT NULL,
-		hours REAL NOT NULL,
-		category TEXT DEFAULT 'general',
-		date TEXT NOT NULL,
-		status TEXT DEFAULT 'pending',
-		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_tasks_date ON tasks(date);
-	CREATE INDEX IF NOT EXISTS idx_tasks_category ON tasks(category);
-	CREATE INDEX IF NOT EXISTS idx_tasks_status ON tasks(status);
-	CREATE INDEX IF NOT EXISTS idx_tasks_description ON tasks(description);
-	`
-
-	_, err := dm.db.Exec(query)
-	return err
-}
-
 // LoadTasks carga todas las tareas desde la base de datos
 func (dm *DatabaseManager) LoadTasks() ([]workflow.Task, error) {
-	query := `SELECT id, description, hours, category, date, status, created_at FROM tasks ORDER BY date DESC, id DESC`
+	query := `SELECT ` + taskColumns + ` FROM ` + taskTables + ` ORDER BY t.date DESC, t.id DESC`
 
 	rows, err := dm.db.Query(query)
 	if err != nil {
@@ -90,37 +79,86 @@ func (dm *DatabaseManager) LoadTasks() ([]workflow.Task, error) {
 	}
 	defer rows.Close()
 
-	var tasks []workflow.Task
-	for rows.Next() {
-		var task workflow.Task
-		var createdAtStr string
+	return scanTasks(rows)
+}
 
-		err := rows.Scan(&task.ID, &task.Description, &task.Hours, &task.Category, &task.Date, &task.Status, &createdAtStr)
-		if err != nil {
-			return nil, fmt.Errorf("could not scan task: %v", err)
-		}
+// SaveTask guarda una nueva tarea en la base de datos junto con su registro de horas
+func (dm *DatabaseManager) SaveTask(task *workflow.Task) error {
+	return dm.withTx(func(tx *sql.Tx) error {
+		return insertTask(tx, task)
+	})
+}
 
-		// Parsear created_at
-		if createdAt, err := time.Parse("2006-01-02 15:04:05", createdAtStr); err == nil {
-			task.CreatedAt = createdAt
-		} else {
-			task.CreatedAt = time.Now()
-		}
+// UpdateTask actualiza una tarea existente.
+// Si las horas totales cambian, la diferencia se refleja en sus registros de horas.
+func (dm *DatabaseManager) UpdateTask(task *workflow.Task) error {
+	return dm.withTx(func(tx *sql.Tx) error {
+		return updateTask(tx, task)
+	})
+}
+
+// DeleteTask envía una tarea a la papelera; las consultas dejan de mostrarla
+func (dm *DatabaseManager) DeleteTask(id int) error {
+	return dm.withTx(func(tx *sql.Tx) error {
+		return setTaskTrashed(tx, id, true)
+	})
+}
 
-		tasks = append(tasks, task)
+// removeTask borra la fila de una tarea junto con sus etiquetas y registros de horas
+func removeTask(tx *sql.Tx, id int) error {
+	if _, err := tx.Exec(`DELETE FROM time_entries WHERE task_id = ?`, id); err != nil {
+		return fmt.Errorf("could not delete time entries: %v", err)
 	}
 
-	return tasks, nil
+	if _, err := tx.Exec(`DELETE FROM task_tags WHERE task_id = ?`, id); err != nil {
+		return fmt.Errorf("could not delete task tags: %v", err)
+	}
+
+	result, err := tx.Exec(`DELETE FROM tasks WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("could not delete task: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("could not get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("task with ID %d not found", id)
+	}
+
+	return nil
 }
 
-// SaveTask guarda una nueva tarea en la base de datos
-func (dm *DatabaseManager) SaveTask(task *workflow.Task) error {
+// withTx ejecuta fn dentro de una transacción, confirmándola solo si no hay error
+func (dm *DatabaseManager) withTx(fn func(tx *sql.Tx) error) error {
+	tx, err := dm.db.Begin()
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// insertTask inserta una tarea y registra sus horas iniciales en la fecha de la tarea
+func insertTask(tx *sql.Tx, task *workflow.Task) error {
+	projectID, err := resolveProjectID(tx, task.Project, false)
+	if err != nil {
+		return err
+	}
+
 	query := `
-	INSERT INTO tasks (description, hours, category, date, status, created_at)
-	VALUES (?, ?, ?, ?, ?, ?)
+	INSERT INTO tasks (description, hours, category, date, status, created_at, project_id, billable, deleted_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
-	result, err := dm.db.Exec(query, task.Description, task.Hours, task.Category, task.Date, task.Status, task.CreatedAt)
+	result, err := tx.Exec(query, task.Description, task.Hours, task.Category, task.Date, task.Status, task.CreatedAt.UTC(), projectID, nullableBool(task.Billable), nullableTime(task.DeletedAt))
 	if err != nil {
 		return fmt.Errorf("could not insert task: %v", err)
 	}
@@ -132,18 +170,62 @@ func (dm *DatabaseManager) SaveTask(task *workflow.Task) error {
 	}
 
 	task.ID = int(id)
-	return nil
+
+	if err := replaceTaskTags(tx, task.ID, task.Tags); err != nil {
+		return err
+	}
+
+	if task.Hours != 0 {
+		entry := &workflow.TimeEntry{TaskID: task.ID, Date: task.Date, Hours: task.Hours}
+		if err := insertTimeEntry(tx, entry); err != nil {
+			return err
+		}
+	}
+
+	after, err := getTaskTx(tx, task.ID)
+	if err != nil {
+		return err
+	}
+
+	return recordHistory(tx, historyRecord{TaskID: task.ID, Operation: workflow.HistoryCreate, After: after})
 }
 
-// UpdateTask actualiza una tarea existente
-func (dm *DatabaseManager) UpdateTask(task *workflow.Task) error {
+// updateTask actualiza una tarea y registra en el historial su estado anterior y posterior
+func updateTask(tx *sql.Tx, task *workflow.Task) error {
+	before, err := getTaskTx(tx, task.ID)
+	if err != nil {
+		return err
+	}
+	if before == nil {
+		return fmt.Errorf("task with ID %d not found", task.ID)
+	}
+
+	if err := writeTask(tx, task); err != nil {
+		return err
+	}
+
+	after, err := getTaskTx(tx, task.ID)
+	if err != nil {
+		return err
+	}
+
+	return recordHistory(tx, historyRecord{TaskID: task.ID, Operation: workflow.HistoryUpdate, Before: before, After: after})
+}
+
+// writeTask guarda los campos de una tarea y ajusta sus registros de horas al nuevo total
+func writeTask(tx *sql.Tx, task *workflow.Task) error {
+	projectID, err := resolveProjectID(tx, task.Project, true)
+	if err != nil {
+		return err
+	}
+
 	query := `
 	UPDATE tasks 
-	SET description = ?, hours = ?, category = ?, date = ?, status = ?, updated_at = CURRENT_TIMESTAMP
+	SET description = ?, category = ?, date = ?, status = ?, project_id = ?, billable = ?, updated_at = CURRENT_TIMESTAMP
 	WHERE id = ?
 	`
 
-	result, err := dm.db.Exec(query, task.Description, task.Hours, task.Category, task.Date, task.Status, task.ID)
+	result, err := tx.Exec(query, task.Description, task.Category, task.Date, task.Status, projectID, nullableBool(task.Billable), task.ID)
 	if err != nil {
 		return fmt.Errorf("could not update task: %v", err)
 	}
@@ -157,38 +239,33 @@ func (dm *DatabaseManager) UpdateTask(task *workflow.Task) error {
 		return fmt.Errorf("task with ID %d not found", task.ID)
 	}
 
-	return nil
+	if err := replaceTaskTags(tx, task.ID, task.Tags); err != nil {
+		return err
+	}
+
+	return adjustTaskHours(tx, task)
 }
 
-// DeleteTask elimina una tarea por ID
-func (dm *DatabaseManager) DeleteTask(id int) error {
-	query := `DELETE FROM tasks WHERE id = ?`
+// getTaskTx lee una tarea dentro de una transacción; devuelve nil si no existe
+func getTaskTx(tx *sql.Tx, id int) (*workflow.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM ` + taskTables + ` WHERE t.id = ?`
 
-	result, err := dm.db.Exec(query, id)
+	task, err := scanTask(tx.QueryRow(query, id))
 	if err != nil {
-		return fmt.Errorf("could not delete task: %v", err)
-	}
-
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("could not get rows affected: %v", err)
-	}
-
-	if rowsAffected == 0 {
-		return fmt.Errorf("task with ID %d not found", id)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not scan task: %v", err)
 	}
 
-	return nil
+	return task, nil
 }
 
 // GetTaskByID obtiene una tarea específica por ID
 func (dm *DatabaseManager) GetTaskByID(id int) (*workflow.Task, error) {
-	query := `SELECT id, description, hours, category, date, status, created_at FROM tasks WHERE id = ?`
-
-	var task workflow.Task
-	var createdAtStr string
+	query := `SELECT ` + taskColumns + ` FROM ` + taskTables + ` WHERE t.id = ? AND t.deleted_at IS NULL`
 
-	err := dm.db.QueryRow(query, id).Scan(&task.ID, &task.Description, &task.Hours, &task.Category, &task.Date, &task.Status, &createdAtStr)
+	task, err := scanTask(dm.db.QueryRow(query, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("task with ID %d not found", id)
@@ -196,72 +273,75 @@ func (dm *DatabaseManager) GetTaskByID(id int) (*workflow.Task, error) {
 		return nil, fmt.Errorf("could not scan task: %v", err)
 	}
 
-	// Parsear created_at
-	if createdAt, err := time.Parse("2006-01-02 15:04:05", createdAtStr); err == nil {
-		task.CreatedAt = createdAt
-	} else {
-		task.CreatedAt = time.Now()
-	}
-
-	return &task, nil
+	return task, nil
 }
 
-// GetTasksByDate obtiene tareas de una fecha específica
-func (dm *DatabaseManager) GetTasksByDate(date string) ([]workflow.Task, error) {
-	query := `SELECT id, description, hours, category, date, status, created_at FROM tasks WHERE date = ? ORDER BY id`
-
-	rows, err := dm.db.Query(query, date)
-	if err != nil {
-		return nil, fmt.Errorf("could not query tasks by date: %v", err)
-	}
-	defer rows.Close()
+// maxQueryIDs limita los IDs enviados en cada consulta, por debajo del máximo de parámetros de SQLite
+const maxQueryIDs = 500
 
+// GetTasksByIDs obtiene las tareas con los IDs indicados, ordenadas por ID.
+// Los IDs que no existen o están en la papelera se omiten.
+func (dm *DatabaseManager) GetTasksByIDs(ids []int) ([]workflow.Task, error) {
 	var tasks []workflow.Task
-	for rows.Next() {
-		var task workflow.Task
-		var createdAtStr string
+	for start := 0; start < len(ids); start += maxQueryIDs {
+		chunk := ids[start:min(start+maxQueryIDs, len(ids))]
 
-		err := rows.Scan(&task.ID, &task.Description, &task.Hours, &task.Category, &task.Date, &task.Status, &createdAtStr)
-		if err != nil {
-			return nil, fmt.Errorf("could not scan task: %v", err)
+		args := make([]interface{}, len(chunk))
+		for i, id := range chunk {
+			args[i] = id
 		}
 
-		// Parsear created_at
-		if createdAt, err := time.Parse("2006-01-02 15:04:05", createdAtStr); err == nil {
-			task.CreatedAt = createdAt
-		} else {
-			task.CreatedAt = time.Now()
+		query := `SELECT ` + taskColumns + ` FROM ` + taskTables + `
+		WHERE t.id IN (` + placeholders(len(chunk)) + `) AND t.deleted_at IS NULL`
+		rows, err := dm.db.Query(query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("could not query tasks by ID: %v", err)
 		}
-
-		tasks = append(tasks, task)
+		found, err := scanTasks(rows)
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, found...)
 	}
 
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].ID < tasks[j].ID })
+	return tasks, nil
+}
+
+// GetTasksByDate obtiene las tareas trabajadas en una fecha específica,
+// con las horas registradas ese día
+func (dm *DatabaseManager) GetTasksByDate(date string) ([]workflow.Task, error) {
+	tasks, err := dm.SearchTasksInRange(TaskFilter{From: date, To: date})
+	if err != nil {
+		return nil, fmt.Errorf("could not query tasks by date: %v", err)
+	}
 	return tasks, nil
 }
 
 // SearchTasks busca tareas según criterios específicos
 func (dm *DatabaseManager) SearchTasks(query string, category string, status string, date string) ([]workflow.Task, error) {
-	baseQuery := `SELECT id, description, hours, category, date, status, created_at FROM tasks WHERE 1=1`
+	baseQuery := `SELECT ` + taskColumns + ` FROM ` + taskTables + ` WHERE t.deleted_at IS NULL`
 	var args []interface{}
 	var conditions []string
 
 	if query != "" {
-		conditions = append(conditions, "description LIKE ?")
+		conditions = append(conditions, "t.description LIKE ?")
 		args = append(args, "%"+query+"%")
 	}
 
 	if category != "" {
-		conditions = append(conditions, "category = ?")
+		conditions = append(conditions, "t.category = ?")
 		args = append(args, category)
 	}
 
 	if status != "" {
-		conditions = append(conditions, "status = ?")
+		conditions = append(conditions, "t.status = ?")
 		args = append(args, status)
 	}
 
 	if date != "" {
-		conditions = append(conditions, "date = ?")
+		conditions = append(conditions, "t.date = ?")
 		args = append(args, date)
 	}
 
@@ -272,7 +352,7 @@ func (dm *DatabaseManager) SearchTasks(query string, category string, status str
 		}
 	}
 
-	baseQuery += " ORDER BY date DESC, id DESC"
+	baseQuery += " ORDER BY t.date DESC, t.id DESC"
 
 	rows, err := dm.db.Query(baseQuery, args...)
 	if err != nil {
@@ -280,29 +360,186 @@ func (dm *DatabaseManager) SearchTasks(query string, category string, status str
 	}
 	defer rows.Close()
 
+	return scanTasks(rows)
+}
+
+// SearchTasksInRange busca tareas trabajadas entre dos fechas (inclusive) aplicando los filtros indicados.
+// Cada tarea aparece una vez por día trabajado, con Date y Hours de ese día.
+// Las tareas sin horas registradas aparecen en su fecha con 0 horas.
+func (dm *DatabaseManager) SearchTasksInRange(filter TaskFilter) ([]workflow.Task, error) {
+	conditions, args := taskFilterConditions(filter)
+
+	// Las horas se buscan por fecha en time_entries y las tareas sin horas por su fecha,
+	// cada consulta con su índice, y se unen en un solo resultado.
+	// El '+' evita que SQLite prefiera el índice de la papelera al de la fecha.
+	entryDates, entryArgs := dateRangeCondition("e.date", filter.From, filter.To)
+	taskDates, taskArgs := dateRangeCondition("t.date", filter.From, filter.To)
+
+	baseQuery := `SELECT ` + workedTaskColumns + `
+	FROM ` + taskTables + ` JOIN time_entries e ON e.task_id = t.id
+	WHERE +t.deleted_at IS NULL` + entryDates + conditions + `
+	GROUP BY t.id, e.date
+	UNION ALL
+	SELECT ` + unworkedTaskColumns + `
+	FROM ` + taskTables + `
+	WHERE +t.deleted_at IS NULL` + taskDates + conditions + `
+	AND NOT EXISTS (SELECT 1 FROM time_entries e WHERE e.task_id = t.id)
+	ORDER BY worked_date, task_id`
+
+	var queryArgs []interface{}
+	queryArgs = append(queryArgs, entryArgs...)
+	queryArgs = append(queryArgs, args...)
+	queryArgs = append(queryArgs, taskArgs...)
+	queryArgs = append(queryArgs, args...)
+
+	rows, err := dm.db.Query(baseQuery, queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query tasks in range: %v", err)
+	}
+	defer rows.Close()
+
+	return scanTasks(rows)
+}
+
+// dateRangeCondition devuelve la condición SQL que limita una columna de fecha al rango indicado
+func dateRangeCondition(column string, from string, to string) (string, []interface{}) {
+	switch {
+	case from != "" && to != "":
+		return " AND " + column + " BETWEEN ? AND ?", []interface{}{from, to}
+	case from != "":
+		return " AND " + column + " >= ?", []interface{}{from}
+	case to != "":
+		return " AND " + column + " <= ?", []interface{}{to}
+	}
+	return "", nil
+}
+
+// taskFilterConditions devuelve las condiciones SQL de los filtros de tarea que no dependen de la fecha
+func taskFilterConditions(filter TaskFilter) (string, []interface{}) {
+	var conditions string
+	var args []interface{}
+
+	if filter.Category != "" {
+		conditions += " AND t.category = ?"
+		args = append(args, filter.Category)
+	}
+
+	if filter.Status != "" {
+		conditions += " AND t.status = ?"
+		args = append(args, filter.Status)
+	}
+
+	if filter.Query != "" {
+		conditions += " AND t.description LIKE ?"
+		args = append(args, "%"+filter.Query+"%")
+	}
+
+	if filter.Project != "" {
+		conditions += " AND p.name = ?"
+		args = append(args, filter.Project)
+	}
+
+	if filter.Client != "" {
+		conditions += " AND c.name = ?"
+		args = append(args, filter.Client)
+	}
+
+	if len(filter.Tags) > 0 {
+		conditions += " AND t.id IN (SELECT task_id FROM task_tags WHERE tag IN (" + placeholders(len(filter.Tags)) + ") GROUP BY task_id HAVING COUNT(DISTINCT tag) = ?)"
+		for _, tag := range filter.Tags {
+			args = append(args, tag)
+		}
+		args = append(args, len(filter.Tags))
+	}
+
+	if len(filter.AnyTags) > 0 {
+		conditions += " AND t.id IN (SELECT task_id FROM task_tags WHERE tag IN (" + placeholders(len(filter.AnyTags)) + "))"
+		for _, tag := range filter.AnyTags {
+			args = append(args, tag)
+		}
+	}
+
+	return conditions, args
+}
+
+// rowScanner abstrae *sql.Row y *sql.Rows para reutilizar el escaneo de tareas
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanTask lee una tarea desde una fila con las columnas de taskColumns
+func scanTask(row rowScanner) (*workflow.Task, error) {
+	var task workflow.Task
+	var createdAt interface{}
+
+	var tags string
+	var billable sql.NullBool
+	var deletedAt interface{}
+
+	if err := row.Scan(&task.ID, &task.Description, &task.Hours, &task.Category, &task.Date, &task.Status, &createdAt, &task.Project, &task.Client, &tags, &billable, &deletedAt); err != nil {
+		return nil, err
+	}
+
+	task.Tags = splitTags(tags)
+	if billable.Valid {
+		task.Billable = &billable.Bool
+	}
+	if deletedAt != nil {
+		task.DeletedAt = parseTimestamp(deletedAt)
+	}
+
+	task.CreatedAt = parseTimestamp(createdAt)
+	return &task, nil
+}
+
+// scanTasks lee todas las tareas de un conjunto de filas
+func scanTasks(rows *sql.Rows) ([]workflow.Task, error) {
 	var tasks []workflow.Task
 	for rows.Next() {
-		var task workflow.Task
-		var createdAtStr string
-
-		err := rows.Scan(&task.ID, &task.Description, &task.Hours, &task.Category, &task.Date, &task.Status, &createdAtStr)
+		task, err := scanTask(rows)
 		if err != nil {
 			return nil, fmt.Errorf("could not scan task: %v", err)
 		}
+		tasks = append(tasks, *task)
+	}
 
-		// Parsear created_at
-		if createdAt, err := time.Parse("2006-01-02 15:04:05", createdAtStr); err == nil {
-			task.CreatedAt = createdAt
-		} else {
-			task.CreatedAt = time.Now()
-		}
-
-		tasks = append(tasks, task)
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not read tasks: %v", err)
 	}
 
 	return tasks, nil
 }
 
+// nullableBool convierte un valor opcional sin definir en NULL
+func nullableBool(value *bool) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+// parseTimestamp convierte un valor de columna TIMESTAMP a time.Time en UTC.
+// El driver devuelve time.Time cuando reconoce el formato y string en otro caso;
+// los valores sin zona vienen de CURRENT_TIMESTAMP, que SQLite guarda en UTC.
+func parseTimestamp(value interface{}) time.Time {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC()
+	case string:
+		formats := []string{
+			"2006-01-02 15:04:05.999999999-07:00",
+			"2006-01-02T15:04:05Z07:00",
+			"2006-01-02 15:04:05",
+		}
+		for _, format := range formats {
+			if t, err := time.ParseInLocation(format, v, time.UTC); err == nil {
+				return t.UTC()
+			}
+		}
+	}
+	return time.Now().UTC()
+}
+
 // GetDatabasePath devuelve la ruta de la base de datos
 func (dm *DatabaseManager) GetDatabasePath() string {
 	return dm.dbPath
@@ -0,0 +1,151 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// newTestDatabase crea una base de datos temporal con todas las migraciones aplicadas
+func newTestDatabase(t *testing.T) *DatabaseManager {
+	t.Helper()
+	dm := NewDatabaseManager(t.TempDir())
+	if err := dm.Init(); err != nil {
+		t.Fatalf("Init error: %v", err)
+	}
+	t.Cleanup(func() { dm.Close() })
+	return dm
+}
+
+// saveTestTask guarda una tarea de prueba y devuelve su ID
+func saveTestTask(t *testing.T, dm *DatabaseManager, description string, hours float64, date string) int {
+	t.Helper()
+	task := &workflow.Task{Description: description, Hours: hours, Category: "tech", Date: date, Status: workflow.StatusPending}
+	if err := dm.SaveTask(task); err != nil {
+		t.Fatalf("SaveTask error: %v", err)
+	}
+	return task.ID
+}
+
+func TestSearchTasksInRange(t *testing.T) {
+	dm := newTestDatabase(t)
+
+	worked := saveTestTask(t, dm, "worked", 2, "2025-07-01")
+	if err := dm.AddTimeEntry(&workflow.TimeEntry{TaskID: worked, Date: "2025-07-03", Hours: 1.5}); err != nil {
+		t.Fatalf("AddTimeEntry error: %v", err)
+	}
+	empty := saveTestTask(t, dm, "empty", 0, "2025-07-02")
+	saveTestTask(t, dm, "outside", 1, "2025-08-01")
+	trashed := saveTestTask(t, dm, "trashed", 1, "2025-07-02")
+	if err := dm.DeleteTask(trashed); err != nil {
+		t.Fatalf("DeleteTask error: %v", err)
+	}
+
+	type row struct {
+		id    int
+		date  string
+		hours float64
+	}
+	tests := []struct {
+		name   string
+		filter TaskFilter
+		want   []row
+	}{
+		{
+			name:   "one row per worked day plus tasks without hours",
+			filter: TaskFilter{From: "2025-07-01", To: "2025-07-31"},
+			want:   []row{{worked, "2025-07-01", 2}, {empty, "2025-07-02", 0}, {worked, "2025-07-03", 1.5}},
+		},
+		{
+			name:   "a task is found by a later worked day",
+			filter: TaskFilter{From: "2025-07-03", To: "2025-07-03"},
+			want:   []row{{worked, "2025-07-03", 1.5}},
+		},
+		{
+			name:   "open start",
+			filter: TaskFilter{To: "2025-07-02"},
+			want:   []row{{worked, "2025-07-01", 2}, {empty, "2025-07-02", 0}},
+		},
+		{
+			name:   "filters apply to both kinds of task",
+			filter: TaskFilter{From: "2025-07-01", To: "2025-07-31", Query: "empty"},
+			want:   []row{{empty, "2025-07-02", 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tasks, err := dm.SearchTasksInRange(tt.filter)
+			if err != nil {
+				t.Fatalf("SearchTasksInRange error: %v", err)
+			}
+			if len(tasks) != len(tt.want) {
+				t.Fatalf("got %d tasks, want %d: %+v", len(tasks), len(tt.want), tasks)
+			}
+			for i, task := range tasks {
+				got := row{task.ID, task.Date, task.Hours}
+				if got != tt.want[i] {
+					t.Errorf("task %d = %+v, want %+v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGetTasksByIDs(t *testing.T) {
+	dm := newTestDatabase(t)
+
+	first := saveTestTask(t, dm, "first", 1, "2025-07-01")
+	second := saveTestTask(t, dm, "second", 1, "2025-07-02")
+	trashed := saveTestTask(t, dm, "trashed", 1, "2025-07-02")
+	if err := dm.DeleteTask(trashed); err != nil {
+		t.Fatalf("DeleteTask error: %v", err)
+	}
+
+	tasks, err := dm.GetTasksByIDs([]int{second, trashed, 99, first})
+	if err != nil {
+		t.Fatalf("GetTasksByIDs error: %v", err)
+	}
+
+	var ids []int
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	if len(ids) != 2 || ids[0] != first || ids[1] != second {
+		t.Errorf("got IDs %v, want [%d %d]", ids, first, second)
+	}
+}
+
+// TestForeignKeys comprueba que las claves foráneas estén activas en cada conexión
+func TestForeignKeys(t *testing.T) {
+	dm := newTestDatabase(t)
+
+	task := saveTestTask(t, dm, "linked", 1, "2025-07-01")
+	if err := dm.SaveTimer(&workflow.Timer{Description: "linked", Category: "tech", TaskID: task}); err != nil {
+		t.Fatalf("SaveTimer error: %v", err)
+	}
+
+	if _, err := dm.db.Exec(`DELETE FROM tasks WHERE id = ?`, task); err != nil {
+		t.Fatalf("delete task error: %v", err)
+	}
+
+	var entries int
+	if err := dm.db.QueryRow(`SELECT COUNT(*) FROM time_entries WHERE task_id = ?`, task).Scan(&entries); err != nil {
+		t.Fatalf("count time entries error: %v", err)
+	}
+	if entries != 0 {
+		t.Errorf("%d time entries left after deleting their task, want them deleted", entries)
+	}
+
+	timer, err := dm.GetActiveTimer()
+	if err != nil || timer == nil {
+		t.Fatalf("GetActiveTimer = %v, %v", timer, err)
+	}
+	if timer.TaskID != 0 {
+		t.Errorf("timer task = %d after deleting it, want none", timer.TaskID)
+	}
+
+	if _, err := dm.db.Exec(`INSERT INTO time_entries (task_id, date, hours) VALUES (999, '2025-07-01', 1)`); err == nil {
+		t.Errorf("inserted a time entry for a missing task")
+	}
+}
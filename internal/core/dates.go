@@ -0,0 +1,136 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateInputExamples enumera los formatos de fecha aceptados para los mensajes de error
+const dateInputExamples = `YYYY-MM-DD, "today", "yesterday", "tomorrow", "mon", "last friday", "next tue", "-3d", "+2w" or "2025-W30"`
+
+var (
+	relativeDatePattern = regexp.MustCompile(`^([+-]?)(\d+)([dw])$`)
+	isoWeekPattern      = regexp.MustCompile(`^(\d{4})-w(\d{1,2})$`)
+)
+
+// DateRange es un rango de fechas inclusivo en formato YYYY-MM-DD
+type DateRange struct {
+	From string
+	To   string
+}
+
+// IsSingleDay indica si el rango abarca un solo día
+func (r DateRange) IsSingleDay() bool {
+	return r.From == r.To
+}
+
+// ParseDate interpreta una fecha exacta o relativa y la devuelve en formato YYYY-MM-DD.
+// Las semanas ISO no son válidas aquí porque abarcan más de un día.
+func ParseDate(value string, now time.Time) (string, error) {
+	dateRange, err := ParseDateRange(value, now)
+	if err != nil {
+		return "", err
+	}
+	if !dateRange.IsSingleDay() {
+		return "", fmt.Errorf("invalid date: %s is a week, a single day is required here", value)
+	}
+	return dateRange.From, nil
+}
+
+// ParseDateRange interpreta una fecha exacta, una fecha relativa a now o una semana ISO (2025-W30).
+// Los nombres de día sin prefijo se refieren al más reciente, contando el día de hoy.
+func ParseDateRange(value string, now time.Time) (DateRange, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(value)), " ")
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	day := func(t time.Time) DateRange {
+		date := t.Format("2006-01-02")
+		return DateRange{From: date, To: date}
+	}
+
+	switch normalized {
+	case "":
+		return DateRange{}, fmt.Errorf("invalid date: empty value (use %s)", dateInputExamples)
+	case "today":
+		return day(today), nil
+	case "yesterday":
+		return day(today.AddDate(0, 0, -1)), nil
+	case "tomorrow":
+		return day(today.AddDate(0, 0, 1)), nil
+	}
+
+	if t, err := time.Parse("2006-01-02", normalized); err == nil {
+		return day(t), nil
+	}
+
+	if match := relativeDatePattern.FindStringSubmatch(normalized); match != nil {
+		n, err := strconv.Atoi(match[2])
+		if err != nil {
+			return DateRange{}, fmt.Errorf("invalid date: %s (use %s)", value, dateInputExamples)
+		}
+		if match[1] == "-" {
+			n = -n
+		}
+		if match[3] == "w" {
+			n *= 7
+		}
+		return day(today.AddDate(0, 0, n)), nil
+	}
+
+	if match := isoWeekPattern.FindStringSubmatch(normalized); match != nil {
+		year, _ := strconv.Atoi(match[1])
+		week, _ := strconv.Atoi(match[2])
+		monday, err := isoWeekStart(year, week, now.Location())
+		if err != nil {
+			return DateRange{}, fmt.Errorf("invalid date: %s (%v)", value, err)
+		}
+		return DateRange{From: monday.Format("2006-01-02"), To: monday.AddDate(0, 0, 6).Format("2006-01-02")}, nil
+	}
+
+	modifier, name, hasModifier := strings.Cut(normalized, " ")
+	if !hasModifier {
+		modifier, name = "", normalized
+	}
+	if weekday, exists := weekdayNames[name]; exists {
+		back := (int(today.Weekday()-weekday) + 7) % 7
+		forward := (7 - back) % 7
+		switch modifier {
+		case "":
+			// El más reciente, incluido hoy
+			return day(today.AddDate(0, 0, -back)), nil
+		case "last":
+			// El más reciente, sin contar hoy
+			if back == 0 {
+				back = 7
+			}
+			return day(today.AddDate(0, 0, -back)), nil
+		case "next":
+			// El próximo, sin contar hoy
+			if forward == 0 {
+				forward = 7
+			}
+			return day(today.AddDate(0, 0, forward)), nil
+		}
+	}
+
+	return DateRange{}, fmt.Errorf("invalid date: %s (use %s)", value, dateInputExamples)
+}
+
+// isoWeekStart devuelve el lunes de una semana ISO 8601
+func isoWeekStart(year int, week int, loc *time.Location) (time.Time, error) {
+	if week < 1 {
+		return time.Time{}, fmt.Errorf("week must be at least 1")
+	}
+	// El 28 de diciembre siempre cae en la última semana ISO del año
+	if _, lastWeek := time.Date(year, time.December, 28, 0, 0, 0, 0, loc).ISOWeek(); week > lastWeek {
+		return time.Time{}, fmt.Errorf("%d has only %d ISO weeks", year, lastWeek)
+	}
+
+	// El 4 de enero siempre cae en la semana 1
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, loc)
+	offset := int(jan4.Weekday()+6) % 7
+	return jan4.AddDate(0, 0, -offset+(week-1)*7), nil
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseDateRange comprueba las fechas exactas, relativas y las semanas ISO
+func TestParseDateRange(t *testing.T) {
+	// Miércoles 23 de julio de 2025
+	now := time.Date(2025, time.July, 23, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		value   string
+		from    string
+		to      string
+		wantErr bool
+	}{
+		{value: "2025-07-01", from: "2025-07-01", to: "2025-07-01"},
+		{value: "today", from: "2025-07-23", to: "2025-07-23"},
+		{value: "Yesterday", from: "2025-07-22", to: "2025-07-22"},
+		{value: "tomorrow", from: "2025-07-24", to: "2025-07-24"},
+		{value: "-3d", from: "2025-07-20", to: "2025-07-20"},
+		{value: "3d", from: "2025-07-26", to: "2025-07-26"},
+		{value: "+2w", from: "2025-08-06", to: "2025-08-06"},
+		{value: "mon", from: "2025-07-21", to: "2025-07-21"},
+		{value: "wed", from: "2025-07-23", to: "2025-07-23"},
+		{value: "last wed", from: "2025-07-16", to: "2025-07-16"},
+		{value: "next wednesday", from: "2025-07-30", to: "2025-07-30"},
+		{value: "  Last   Friday ", from: "2025-07-18", to: "2025-07-18"},
+		{value: "next mon", from: "2025-07-28", to: "2025-07-28"},
+		{value: "2025-W30", from: "2025-07-21", to: "2025-07-27"},
+		{value: "2025-w1", from: "2024-12-30", to: "2025-01-05"},
+		{value: "2020-W53", from: "2020-12-28", to: "2021-01-03"},
+		{value: "2021-W53", wantErr: true},
+		{value: "2025-W0", wantErr: true},
+		{value: "", wantErr: true},
+		{value: "someday", wantErr: true},
+		{value: "2025-13-01", wantErr: true},
+		{value: "previous mon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseDateRange(tt.value, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDateRange(%q) = %+v, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDateRange(%q) error: %v", tt.value, err)
+			}
+			if got.From != tt.from || got.To != tt.to {
+				t.Errorf("ParseDateRange(%q) = %s..%s, want %s..%s", tt.value, got.From, got.To, tt.from, tt.to)
+			}
+		})
+	}
+}
+
+// TestParseDate comprueba que una semana ISO no se acepte como fecha de un día
+func TestParseDate(t *testing.T) {
+	now := time.Date(2025, time.July, 23, 0, 0, 0, 0, time.UTC)
+
+	if got, err := ParseDate("last friday", now); err != nil || got != "2025-07-18" {
+		t.Errorf("ParseDate(last friday) = %q, %v, want 2025-07-18", got, err)
+	}
+	if _, err := ParseDate("2025-W30", now); err == nil {
+		t.Errorf("ParseDate(2025-W30) should fail for a week")
+	}
+}
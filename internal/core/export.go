@@ -0,0 +1,140 @@
+package core
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// ExportSchemaVersion es la versión del formato de exportación JSON.
+// Se incrementa cuando cambia el significado de un campo existente.
+const ExportSchemaVersion = 1
+
+// ExportedTask es una tarea exportada junto con sus registros de horas
+type ExportedTask struct {
+	workflow.Task
+	TimeEntries []workflow.TimeEntry `json:"time_entries,omitempty"`
+}
+
+// ExportEnvelope es el documento completo de una exportación JSON
+type ExportEnvelope struct {
+	SchemaVersion int            `json:"schema_version"`
+	ExportedAt    time.Time      `json:"exported_at"`
+	Filters       TaskFilter     `json:"filters"`
+	Tasks         []ExportedTask `json:"tasks"`
+}
+
+// JSONExportWriter escribe una exportación JSON tarea por tarea, sin armar el documento en memoria
+type JSONExportWriter struct {
+	writer *bufio.Writer
+	count  int
+}
+
+// NewJSONExportWriter escribe el encabezado del documento con su versión, fecha y filtros
+func NewJSONExportWriter(w io.Writer, filters TaskFilter, exportedAt time.Time) (*JSONExportWriter, error) {
+	writer := bufio.NewWriter(w)
+
+	filtersJSON, err := json.Marshal(filters)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode export filters: %v", err)
+	}
+	exportedAtJSON, err := json.Marshal(exportedAt.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("could not encode export time: %v", err)
+	}
+
+	if _, err := fmt.Fprintf(writer, "{\n  \"schema_version\": %d,\n  \"exported_at\": %s,\n  \"filters\": %s,\n  \"tasks\": [",
+		ExportSchemaVersion, exportedAtJSON, filtersJSON); err != nil {
+		return nil, err
+	}
+
+	return &JSONExportWriter{writer: writer}, nil
+}
+
+// WriteTask agrega una tarea al documento
+func (e *JSONExportWriter) WriteTask(task ExportedTask) error {
+	data, err := json.MarshalIndent(task, "    ", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode task %d: %v", task.ID, err)
+	}
+
+	separator := ",\n    "
+	if e.count == 0 {
+		separator = "\n    "
+	}
+	if _, err := e.writer.WriteString(separator); err != nil {
+		return err
+	}
+	if _, err := e.writer.Write(data); err != nil {
+		return err
+	}
+
+	e.count++
+	return nil
+}
+
+// Close cierra el documento y vuelca lo pendiente al destino
+func (e *JSONExportWriter) Close() error {
+	closing := "\n  ]\n}\n"
+	if e.count == 0 {
+		closing = "]\n}\n"
+	}
+	if _, err := e.writer.WriteString(closing); err != nil {
+		return err
+	}
+	return e.writer.Flush()
+}
+
+// Count devuelve la cantidad de tareas escritas
+func (e *JSONExportWriter) Count() int {
+	return e.count
+}
+
+// TaskICalEvent convierte una tarea en un evento de calendario que dura sus horas.
+// Empieza cuando se inició su primer temporizador o, si no tiene, a la hora de created_at
+// en la fecha de la tarea.
+func TaskICalEvent(task workflow.Task, entries []workflow.TimeEntry) ICalEvent {
+	start := time.Time{}
+	for _, entry := range entries {
+		if !entry.Start.IsZero() && (start.IsZero() || entry.Start.Before(start)) {
+			start = entry.Start
+		}
+	}
+
+	if start.IsZero() {
+		start = task.CreatedAt.In(Location())
+		if date, err := time.ParseInLocation("2006-01-02", task.Date, Location()); err == nil {
+			start = time.Date(date.Year(), date.Month(), date.Day(), start.Hour(), start.Minute(), start.Second(), 0, Location())
+		}
+	}
+
+	return ICalEvent{
+		UID:         fmt.Sprintf("task-%d-%d@workflow-cli", task.ID, task.CreatedAt.Unix()),
+		Summary:     task.Description,
+		Description: taskICalDescription(task),
+		Categories:  []string{task.Category},
+		Start:       start,
+		End:         start.Add(time.Duration(math.Round(task.Hours*3600)) * time.Second),
+	}
+}
+
+// taskICalDescription resume en el evento los datos de la tarea que no tienen propiedad propia
+func taskICalDescription(task workflow.Task) string {
+	description := fmt.Sprintf("Hours: %.2f\nStatus: %s", task.Hours, task.Status)
+	if task.Project != "" {
+		description += "\nProject: " + task.Project
+	}
+	if task.Client != "" {
+		description += "\nClient: " + task.Client
+	}
+	if len(task.Tags) > 0 {
+		description += "\nTags: " + strings.Join(task.Tags, ", ")
+	}
+	return description
+}
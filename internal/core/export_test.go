@@ -0,0 +1,107 @@
+package core
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// TestJSONExportRoundTrip comprueba que ReadJSONExport lea el documento de JSONExportWriter sin cambios
+func TestJSONExportRoundTrip(t *testing.T) {
+	billable := true
+	tasks := []ExportedTask{
+		{
+			Task: workflow.Task{ID: 1, Description: "Release notes", Hours: 0.75, Category: "doc", Date: "2025-07-21",
+				Status: workflow.StatusCompleted, CreatedAt: time.Date(2025, time.July, 21, 8, 0, 0, 500, time.UTC),
+				Project: "Web", Client: "Acme", Tags: []string{"docs"}, Billable: &billable},
+			TimeEntries: []workflow.TimeEntry{{ID: 4, TaskID: 1, Date: "2025-07-21", Hours: 0.75, Note: "draft"}},
+		},
+		{Task: workflow.Task{ID: 2, Description: "Café ☕", Hours: 0.25, Category: "meeting", Date: "2025-07-22",
+			Status: workflow.StatusPending, CreatedAt: time.Date(2025, time.July, 22, 9, 0, 0, 0, time.UTC)}},
+	}
+
+	tests := []struct {
+		name  string
+		tasks []ExportedTask
+	}{
+		{name: "tasks", tasks: tasks},
+		{name: "empty", tasks: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			writer, err := NewJSONExportWriter(&buffer, TaskFilter{Category: "doc"}, time.Now())
+			if err != nil {
+				t.Fatalf("NewJSONExportWriter error: %v", err)
+			}
+			for _, task := range tt.tasks {
+				if err := writer.WriteTask(task); err != nil {
+					t.Fatalf("WriteTask error: %v", err)
+				}
+			}
+			if err := writer.Close(); err != nil {
+				t.Fatalf("Close error: %v", err)
+			}
+			if writer.Count() != len(tt.tasks) {
+				t.Errorf("Count = %d, want %d", writer.Count(), len(tt.tasks))
+			}
+
+			got, err := ReadJSONExport(&buffer)
+			if err != nil {
+				t.Fatalf("ReadJSONExport error: %v\n%s", err, buffer.String())
+			}
+			if !reflect.DeepEqual(got, tt.tasks) {
+				t.Errorf("ReadJSONExport =\n%+v\nwant\n%+v", got, tt.tasks)
+			}
+		})
+	}
+}
+
+// TestReadJSONExport comprueba las exportaciones anteriores sin versión y las versiones no soportadas
+func TestReadJSONExport(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []ExportedTask
+		wantErr string
+	}{
+		{
+			name:  "legacy list with created_at in UTC",
+			input: `[{"id":3,"description":"Deploy","hours":1,"category":"deploy","date":"2025-07-21","status":"completed","created_at":"2025-07-21 18:45:00"}]`,
+			want: []ExportedTask{{Task: workflow.Task{ID: 3, Description: "Deploy", Hours: 1, Category: "deploy", Date: "2025-07-21",
+				Status: workflow.StatusCompleted, CreatedAt: time.Date(2025, time.July, 21, 18, 45, 0, 0, time.UTC)}}},
+		},
+		{
+			name:  "unknown keys are skipped",
+			input: `{"schema_version":1,"generator":{"name":"other"},"tasks":[{"id":1,"description":"A","hours":2,"date":"2025-07-21","created_at":"2025-07-21T10:00:00Z"}]}`,
+			want: []ExportedTask{{Task: workflow.Task{ID: 1, Description: "A", Hours: 2, Date: "2025-07-21",
+				CreatedAt: time.Date(2025, time.July, 21, 10, 0, 0, 0, time.UTC)}}},
+		},
+		{name: "newer schema", input: `{"schema_version":99,"tasks":[]}`, wantErr: "unsupported export schema version 99"},
+		{name: "not an export", input: `"tasks"`, wantErr: "unexpected JSON export content"},
+		{name: "invalid task", input: `{"schema_version":1,"tasks":[{"hours":"two"}]}`, wantErr: "could not decode task 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadJSONExport(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ReadJSONExport error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadJSONExport error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadJSONExport =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package core
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// historyRecord es un cambio pendiente de guardar en task_history
+type historyRecord struct {
+	TaskID      int
+	Operation   string
+	Before      *workflow.Task
+	After       *workflow.Task
+	TimeEntries []workflow.TimeEntry // registros de horas de una tarea borrada
+	EntryID     int                  // registro de horas agregado por una operación log
+	RevertsID   int                  // operación deshecha por una operación undo
+}
+
+// recordHistory agrega un cambio al historial; las filas nunca se modifican después
+func recordHistory(tx *sql.Tx, record historyRecord) error {
+	before, err := marshalSnapshot(record.Before)
+	if err != nil {
+		return err
+	}
+	after, err := marshalSnapshot(record.After)
+	if err != nil {
+		return err
+	}
+
+	var entries interface{}
+	if len(record.TimeEntries) > 0 {
+		data, err := json.Marshal(record.TimeEntries)
+		if err != nil {
+			return fmt.Errorf("could not encode time entries: %v", err)
+		}
+		entries = string(data)
+	}
+
+	query := `
+	INSERT INTO task_history (task_id, operation, before_json, after_json, time_entries_json, entry_id, reverts_id)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+	if _, err := tx.Exec(query, record.TaskID, record.Operation, before, after, entries,
+		nullableID(record.EntryID), nullableID(record.RevertsID)); err != nil {
+		return fmt.Errorf("could not record task history: %v", err)
+	}
+
+	return nil
+}
+
+// GetTaskHistory devuelve el historial de una tarea en orden cronológico
+func (dm *DatabaseManager) GetTaskHistory(taskID int) ([]workflow.HistoryEntry, error) {
+	query := `
+	SELECT h.id, h.task_id, h.operation, h.before_json, h.after_json, COALESCE(h.reverts_id, 0),
+		EXISTS (SELECT 1 FROM task_history u WHERE u.reverts_id = h.id), h.created_at
+	FROM task_history h WHERE h.task_id = ? ORDER BY h.id
+	`
+
+	rows, err := dm.db.Query(query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("could not query task history: %v", err)
+	}
+	defer rows.Close()
+
+	var history []workflow.HistoryEntry
+	for rows.Next() {
+		var entry workflow.HistoryEntry
+		var before, after sql.NullString
+		var createdAt interface{}
+
+		if err := rows.Scan(&entry.ID, &entry.TaskID, &entry.Operation, &before, &after, &entry.RevertsID, &entry.Undone, &createdAt); err != nil {
+			return nil, fmt.Errorf("could not scan task history: %v", err)
+		}
+
+		if entry.Before, err = unmarshalSnapshot(before); err != nil {
+			return nil, err
+		}
+		if entry.After, err = unmarshalSnapshot(after); err != nil {
+			return nil, err
+		}
+		entry.CreatedAt = parseTimestamp(createdAt)
+
+		history = append(history, entry)
+	}
+
+	return history, rows.Err()
+}
+
+// Undo deshace las últimas n operaciones que todavía no fueron deshechas.
+// Cada reversión se agrega al historial como una operación undo.
+func (dm *DatabaseManager) Undo(n int) ([]workflow.HistoryEntry, error) {
+	var reverted []workflow.HistoryEntry
+
+	err := dm.withTx(func(tx *sql.Tx) error {
+		pending, err := pendingUndoOperations(tx, n)
+		if err != nil {
+			return err
+		}
+
+		for _, operation := range pending {
+			if err := revertOperation(tx, operation); err != nil {
+				return fmt.Errorf("could not undo %s of task %d: %v", operation.Operation, operation.TaskID, err)
+			}
+			reverted = append(reverted, operation.HistoryEntry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reverted, nil
+}
+
+// undoOperation es una entrada del historial con los datos necesarios para revertirla
+type undoOperation struct {
+	workflow.HistoryEntry
+	TimeEntries []workflow.TimeEntry
+	EntryID     int
+}
+
+// pendingUndoOperations devuelve las últimas n operaciones no deshechas, de la más reciente a la más antigua
+func pendingUndoOperations(tx *sql.Tx, n int) ([]undoOperation, error) {
+	query := `
+	SELECT h.id, h.task_id, h.operation, h.before_json, h.after_json, h.time_entries_json, COALESCE(h.entry_id, 0), h.created_at
+	FROM task_history h
+	WHERE h.operation <> ? AND NOT EXISTS (SELECT 1 FROM task_history u WHERE u.reverts_id = h.id)
+	ORDER BY h.id DESC LIMIT ?
+	`
+
+	rows, err := tx.Query(query, workflow.HistoryUndo, n)
+	if err != nil {
+		return nil, fmt.Errorf("could not query task history: %v", err)
+	}
+	defer rows.Close()
+
+	var operations []undoOperation
+	for rows.Next() {
+		var operation undoOperation
+		var before, after, entries sql.NullString
+		var createdAt interface{}
+
+		if err := rows.Scan(&operation.ID, &operation.TaskID, &operation.Operation, &before, &after, &entries, &operation.EntryID, &createdAt); err != nil {
+			return nil, fmt.Errorf("could not scan task history: %v", err)
+		}
+
+		if operation.Before, err = unmarshalSnapshot(before); err != nil {
+			return nil, err
+		}
+		if operation.After, err = unmarshalSnapshot(after); err != nil {
+			return nil, err
+		}
+		if entries.Valid {
+			if err := json.Unmarshal([]byte(entries.String), &operation.TimeEntries); err != nil {
+				return nil, fmt.Errorf("could not decode time entries: %v", err)
+			}
+		}
+		operation.CreatedAt = parseTimestamp(createdAt)
+
+		operations = append(operations, operation)
+	}
+
+	return operations, rows.Err()
+}
+
+// revertOperation devuelve una tarea al estado previo a una operación
+func revertOperation(tx *sql.Tx, operation undoOperation) error {
+	current, err := getTaskTx(tx, operation.TaskID)
+	if err != nil {
+		return err
+	}
+
+	switch operation.Operation {
+	case workflow.HistoryCreate:
+		if current == nil {
+			return fmt.Errorf("task no longer exists")
+		}
+		if err := removeTask(tx, operation.TaskID); err != nil {
+			return err
+		}
+	case workflow.HistoryDelete:
+		if current != nil {
+			return fmt.Errorf("task ID is already in use")
+		}
+		if err := restoreTask(tx, operation.Before, operation.TimeEntries); err != nil {
+			return err
+		}
+	case workflow.HistoryUpdate:
+		if current == nil {
+			return fmt.Errorf("task no longer exists")
+		}
+		if err := writeTask(tx, operation.Before); err != nil {
+			return err
+		}
+	case workflow.HistoryTrash, workflow.HistoryRestore:
+		if current == nil {
+			return fmt.Errorf("task no longer exists")
+		}
+		if _, err := tx.Exec(`UPDATE tasks SET deleted_at = ? WHERE id = ?`, nullableTime(operation.Before.DeletedAt), operation.TaskID); err != nil {
+			return fmt.Errorf("could not update task trash state: %v", err)
+		}
+	case workflow.HistoryLog:
+		if current == nil {
+			return fmt.Errorf("task no longer exists")
+		}
+		if _, err := tx.Exec(`DELETE FROM time_entries WHERE id = ?`, operation.EntryID); err != nil {
+			return fmt.Errorf("could not delete time entry: %v", err)
+		}
+		if err := refreshTaskHours(tx, operation.TaskID); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown operation '%s'", operation.Operation)
+	}
+
+	after, err := getTaskTx(tx, operation.TaskID)
+	if err != nil {
+		return err
+	}
+
+	return recordHistory(tx, historyRecord{
+		TaskID:    operation.TaskID,
+		Operation: workflow.HistoryUndo,
+		Before:    current,
+		After:     after,
+		RevertsID: operation.ID,
+	})
+}
+
+// restoreTask vuelve a insertar una tarea borrada con su ID y registros de horas originales
+func restoreTask(tx *sql.Tx, task *workflow.Task, entries []workflow.TimeEntry) error {
+	if task == nil {
+		return fmt.Errorf("no snapshot to restore")
+	}
+
+	projectID, err := resolveProjectID(tx, task.Project, true)
+	if err != nil {
+		return err
+	}
+
+	query := `
+	INSERT INTO tasks (id, description, hours, category, date, status, created_at, project_id, billable, deleted_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	if _, err := tx.Exec(query, task.ID, task.Description, task.Hours, task.Category, task.Date, task.Status, task.CreatedAt.UTC(), projectID, nullableBool(task.Billable), nullableTime(task.DeletedAt)); err != nil {
+		return fmt.Errorf("could not restore task: %v", err)
+	}
+
+	if err := replaceTaskTags(tx, task.ID, task.Tags); err != nil {
+		return err
+	}
+
+	for i := range entries {
+		if err := insertTimeEntry(tx, &entries[i]); err != nil {
+			return err
+		}
+	}
+
+	return adjustTaskHours(tx, task)
+}
+
+// marshalSnapshot serializa el estado de una tarea (NULL si no hay tarea)
+func marshalSnapshot(task *workflow.Task) (interface{}, error) {
+	if task == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(task)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode task snapshot: %v", err)
+	}
+	return string(data), nil
+}
+
+// unmarshalSnapshot lee el estado de una tarea guardado en el historial
+func unmarshalSnapshot(value sql.NullString) (*workflow.Task, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+
+	var task workflow.Task
+	if err := json.Unmarshal([]byte(value.String), &task); err != nil {
+		return nil, fmt.Errorf("could not decode task snapshot: %v", err)
+	}
+	return &task, nil
+}
+
+// nullableID convierte un ID cero en NULL
+func nullableID(id int) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
+
+// GetTaskHistory devuelve el historial de cambios de una tarea
+func (tm *TaskManagerSQLite) GetTaskHistory(taskID int) ([]workflow.HistoryEntry, error) {
+	return tm.dbManager.GetTaskHistory(taskID)
+}
+
+// Undo deshace las últimas n operaciones sobre tareas
+func (tm *TaskManagerSQLite) Undo(n int) ([]workflow.HistoryEntry, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("number of operations must be at least 1")
+	}
+	return tm.dbManager.Undo(n)
+}
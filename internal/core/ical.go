@@ -0,0 +1,286 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ICalEvent es un evento (VEVENT) leído de un archivo iCalendar.
+// En los eventos de día completo Start y End son fechas en UTC y End es exclusivo.
+// UnknownTZID guarda un TZID que no se pudo cargar; esas horas se interpretan en la zona configurada.
+type ICalEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Categories  []string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	UnknownTZID string
+}
+
+// icalProperty es una línea de contenido iCalendar ya desplegada
+type icalProperty struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// ParseICal lee los eventos de un archivo iCalendar (RFC 5545)
+func ParseICal(r io.Reader) ([]ICalEvent, error) {
+	lines, err := unfoldICalLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []ICalEvent
+	var current *ICalEvent
+	hasEnd := false
+
+	for number, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		property, err := parseICalProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid iCalendar line %d: %v", number+1, err)
+		}
+
+		switch {
+		case property.Name == "BEGIN" && strings.EqualFold(property.Value, "VEVENT"):
+			current = &ICalEvent{}
+			hasEnd = false
+		case property.Name == "END" && strings.EqualFold(property.Value, "VEVENT"):
+			if current == nil {
+				return nil, fmt.Errorf("invalid iCalendar line %d: END:VEVENT without BEGIN:VEVENT", number+1)
+			}
+			if current.Start.IsZero() {
+				return nil, fmt.Errorf("invalid iCalendar event '%s': missing DTSTART", current.Summary)
+			}
+			if !hasEnd {
+				current.End = current.Start
+				if current.AllDay {
+					current.End = current.Start.AddDate(0, 0, 1)
+				}
+			}
+			events = append(events, *current)
+			current = nil
+		case current == nil:
+			// Propiedades del calendario o de otros componentes
+		case property.Name == "UID":
+			current.UID = property.Value
+		case property.Name == "SUMMARY":
+			current.Summary = unescapeICalText(property.Value)
+		case property.Name == "DESCRIPTION":
+			current.Description = unescapeICalText(property.Value)
+		case property.Name == "CATEGORIES":
+			for _, category := range splitICalList(property.Value) {
+				current.Categories = append(current.Categories, unescapeICalText(category))
+			}
+		case property.Name == "DTSTART":
+			var unknownTZID string
+			if current.Start, current.AllDay, unknownTZID, err = parseICalTime(property); err != nil {
+				return nil, fmt.Errorf("invalid iCalendar line %d: %v", number+1, err)
+			}
+			if unknownTZID != "" {
+				current.UnknownTZID = unknownTZID
+			}
+		case property.Name == "DTEND":
+			var unknownTZID string
+			if current.End, _, unknownTZID, err = parseICalTime(property); err != nil {
+				return nil, fmt.Errorf("invalid iCalendar line %d: %v", number+1, err)
+			}
+			if unknownTZID != "" {
+				current.UnknownTZID = unknownTZID
+			}
+			hasEnd = true
+		}
+	}
+
+	if current != nil {
+		return nil, fmt.Errorf("invalid iCalendar file: event '%s' is not closed", current.Summary)
+	}
+
+	return events, nil
+}
+
+// unfoldICalLines lee las líneas del archivo uniendo las continuaciones (líneas que empiezan con espacio)
+func unfoldICalLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read iCalendar file: %v", err)
+	}
+	return lines, nil
+}
+
+// parseICalProperty separa una línea en nombre, parámetros y valor
+func parseICalProperty(line string) (icalProperty, error) {
+	// El valor empieza en el primer ':' fuera de comillas
+	quoted := false
+	split := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		} else if r == ':' && !quoted {
+			split = i
+			break
+		}
+	}
+	if split < 0 {
+		return icalProperty{}, fmt.Errorf("missing ':' in '%s'", line)
+	}
+
+	parts := strings.Split(line[:split], ";")
+	property := icalProperty{
+		Name:   strings.ToUpper(parts[0]),
+		Params: make(map[string]string),
+		Value:  line[split+1:],
+	}
+	for _, param := range parts[1:] {
+		if key, value, found := strings.Cut(param, "="); found {
+			property.Params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+		}
+	}
+
+	return property, nil
+}
+
+// parseICalTime interpreta un DTSTART o DTEND; devuelve también si es una fecha de día completo.
+// Un TZID desconocido (por ejemplo, una zona de Windows) se interpreta en la zona configurada y se devuelve aparte.
+func parseICalTime(property icalProperty) (time.Time, bool, string, error) {
+	value := strings.TrimSpace(property.Value)
+
+	if strings.EqualFold(property.Params["VALUE"], "DATE") || len(value) == 8 {
+		t, err := time.Parse("20060102", value)
+		if err != nil {
+			return time.Time{}, false, "", fmt.Errorf("invalid date '%s'", value)
+		}
+		return t, true, "", nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		if err != nil {
+			return time.Time{}, false, "", fmt.Errorf("invalid date-time '%s'", value)
+		}
+		return t, false, "", nil
+	}
+
+	// Sin zona explícita se usa TZID o, si no hay o no se reconoce, la zona horaria configurada
+	loc := Location()
+	unknownTZID := ""
+	if tzid := property.Params["TZID"]; tzid != "" {
+		if tz, err := LoadTimezone(tzid); err == nil {
+			loc = tz
+		} else {
+			unknownTZID = tzid
+		}
+	}
+
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	if err != nil {
+		return time.Time{}, false, "", fmt.Errorf("invalid date-time '%s'", value)
+	}
+	return t.UTC(), false, unknownTZID, nil
+}
+
+// unescapeICalText deshace el escapado de los valores de texto iCalendar
+func unescapeICalText(value string) string {
+	replacer := strings.NewReplacer(`\n`, "\n", `\N`, "\n", `\,`, ",", `\;`, ";", `\\`, `\`)
+	return replacer.Replace(value)
+}
+
+// splitICalList separa un valor de lista por las comas que no están escapadas
+func splitICalList(value string) []string {
+	var items []string
+	start := 0
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++
+		case ',':
+			items = append(items, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(items, value[start:])
+}
+
+// WriteICal escribe los eventos como un calendario iCalendar (RFC 5545) con horas en UTC
+func WriteICal(w io.Writer, events []ICalEvent, stamp time.Time) error {
+	writer := bufio.NewWriter(w)
+	write := func(line string) {
+		writer.WriteString(foldICalLine(line))
+	}
+
+	write("BEGIN:VCALENDAR")
+	write("VERSION:2.0")
+	write("PRODID:-//workflow-cli//workflow//EN")
+	write("CALSCALE:GREGORIAN")
+	for _, event := range events {
+		write("BEGIN:VEVENT")
+		write("UID:" + event.UID)
+		write("DTSTAMP:" + stamp.UTC().Format("20060102T150405Z"))
+		if event.AllDay {
+			write("DTSTART;VALUE=DATE:" + event.Start.Format("20060102"))
+			write("DTEND;VALUE=DATE:" + event.End.Format("20060102"))
+		} else {
+			write("DTSTART:" + event.Start.UTC().Format("20060102T150405Z"))
+			write("DTEND:" + event.End.UTC().Format("20060102T150405Z"))
+		}
+		write("SUMMARY:" + escapeICalText(event.Summary))
+		if event.Description != "" {
+			write("DESCRIPTION:" + escapeICalText(event.Description))
+		}
+		if len(event.Categories) > 0 {
+			categories := make([]string, len(event.Categories))
+			for i, category := range event.Categories {
+				categories[i] = escapeICalText(category)
+			}
+			write("CATEGORIES:" + strings.Join(categories, ","))
+		}
+		write("END:VEVENT")
+	}
+	write("END:VCALENDAR")
+
+	return writer.Flush()
+}
+
+// foldICalLine corta las líneas de más de 75 bytes sin partir caracteres UTF-8 y agrega el fin de línea CRLF
+func foldICalLine(line string) string {
+	var folded strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > 75 {
+			folded.WriteString("\r\n ")
+			width = 1
+		}
+		folded.WriteRune(r)
+		width += size
+	}
+	folded.WriteString("\r\n")
+	return folded.String()
+}
+
+// escapeICalText escapa un valor de texto iCalendar
+func escapeICalText(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+	return replacer.Replace(value)
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// useTimezone fija la zona horaria configurada durante un test
+func useTimezone(t *testing.T, name string) {
+	t.Helper()
+	previous := location
+	if err := SetTimezone(name); err != nil {
+		t.Skipf("timezone %s not available: %v", name, err)
+	}
+	t.Cleanup(func() { location = previous })
+}
+
+// icalEvents arma un calendario con las líneas de un evento
+func icalEvents(lines ...string) string {
+	return "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n" + strings.Join(lines, "\r\n") + "\r\nEND:VCALENDAR\r\n"
+}
+
+// TestParseICal comprueba las zonas horarias, los eventos de día completo y el texto escapado
+func TestParseICal(t *testing.T) {
+	useTimezone(t, "UTC")
+	if _, err := LoadTimezone("America/New_York"); err != nil {
+		t.Skipf("timezone database not available: %v", err)
+	}
+
+	utc := func(value string) time.Time {
+		parsed, _ := time.Parse("2006-01-02 15:04", value)
+		return parsed
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  []ICalEvent
+	}{
+		{
+			name: "utc times with folded and escaped text",
+			input: icalEvents("BEGIN:VEVENT", "UID:1", "SUMMARY:Sprint planning\\, team A", " and B",
+				"DESCRIPTION:Line one\\nLine two", "CATEGORIES:Work,Meetings\\, external",
+				"DTSTART:20250721T090000Z", "DTEND:20250721T103000Z", "END:VEVENT"),
+			want: []ICalEvent{{UID: "1", Summary: "Sprint planning, team Aand B", Description: "Line one\nLine two",
+				Categories: []string{"Work", "Meetings, external"}, Start: utc("2025-07-21 09:00"), End: utc("2025-07-21 10:30")}},
+		},
+		{
+			name: "tzid",
+			input: icalEvents("BEGIN:VEVENT", "SUMMARY:Sync",
+				`DTSTART;TZID="America/New_York":20250721T100000`, "DTEND;TZID=America/New_York:20250721T110000", "END:VEVENT"),
+			want: []ICalEvent{{Summary: "Sync", Start: utc("2025-07-21 14:00"), End: utc("2025-07-21 15:00")}},
+		},
+		{
+			name: "unknown tzid uses the configured timezone",
+			input: icalEvents("BEGIN:VEVENT", "SUMMARY:Sync",
+				"DTSTART;TZID=Romance Standard Time:20250721T100000", "DTEND;TZID=Romance Standard Time:20250721T110000", "END:VEVENT"),
+			want: []ICalEvent{{Summary: "Sync", Start: utc("2025-07-21 10:00"), End: utc("2025-07-21 11:00"), UnknownTZID: "Romance Standard Time"}},
+		},
+		{
+			name:  "floating time uses the configured timezone",
+			input: icalEvents("BEGIN:VEVENT", "SUMMARY:Focus", "DTSTART:20250721T080000", "END:VEVENT"),
+			want:  []ICalEvent{{Summary: "Focus", Start: utc("2025-07-21 08:00"), End: utc("2025-07-21 08:00")}},
+		},
+		{
+			name:  "all-day event without end lasts one day",
+			input: icalEvents("BEGIN:VEVENT", "SUMMARY:Holiday", "DTSTART;VALUE=DATE:20251225", "END:VEVENT"),
+			want:  []ICalEvent{{Summary: "Holiday", Start: utc("2025-12-25 00:00"), End: utc("2025-12-26 00:00"), AllDay: true}},
+		},
+		{
+			name: "components other than events are ignored",
+			input: icalEvents("BEGIN:VTIMEZONE", "TZID:Romance Standard Time", "END:VTIMEZONE",
+				"BEGIN:VEVENT", "SUMMARY:One", "DTSTART:20250721T090000Z", "END:VEVENT",
+				"", "BEGIN:VEVENT", "SUMMARY:Two", "DTSTART:20250722T090000Z", "END:VEVENT"),
+			want: []ICalEvent{
+				{Summary: "One", Start: utc("2025-07-21 09:00"), End: utc("2025-07-21 09:00")},
+				{Summary: "Two", Start: utc("2025-07-22 09:00"), End: utc("2025-07-22 09:00")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := ParseICal(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("ParseICal error: %v", err)
+			}
+			if !reflect.DeepEqual(events, tt.want) {
+				t.Errorf("ParseICal =\n%+v\nwant\n%+v", events, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseICalInvalid comprueba que los calendarios mal formados devuelvan un error
+func TestParseICalInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "missing dtstart", input: icalEvents("BEGIN:VEVENT", "SUMMARY:Sync", "END:VEVENT")},
+		{name: "event not closed", input: icalEvents("BEGIN:VEVENT", "DTSTART:20250721T090000Z")},
+		{name: "end without begin", input: icalEvents("END:VEVENT")},
+		{name: "invalid date", input: icalEvents("BEGIN:VEVENT", "DTSTART;VALUE=DATE:2025-07-21", "END:VEVENT")},
+		{name: "invalid date-time", input: icalEvents("BEGIN:VEVENT", "DTSTART:20250721T9Z", "END:VEVENT")},
+		{name: "missing colon", input: icalEvents("BEGIN:VEVENT", "SUMMARY Sync", "END:VEVENT")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseICal(strings.NewReader(tt.input)); err == nil {
+				t.Errorf("ParseICal should fail")
+			}
+		})
+	}
+}
+
+// TestWriteICalRoundTrip comprueba que ParseICal lea lo que escribe WriteICal, incluidas las líneas largas
+func TestWriteICalRoundTrip(t *testing.T) {
+	events := []ICalEvent{
+		{
+			UID:         "task-1@workflow",
+			Summary:     strings.Repeat("Revisión de código; ", 6),
+			Description: "Notas, con comas\ny saltos de línea",
+			Categories:  []string{"review", "team, backend"},
+			Start:       time.Date(2025, time.July, 21, 9, 0, 0, 0, time.UTC),
+			End:         time.Date(2025, time.July, 21, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			UID:     "holiday@workflow",
+			Summary: "Holiday",
+			Start:   time.Date(2025, time.December, 25, 0, 0, 0, 0, time.UTC),
+			End:     time.Date(2025, time.December, 26, 0, 0, 0, 0, time.UTC),
+			AllDay:  true,
+		},
+	}
+
+	var buffer bytes.Buffer
+	if err := WriteICal(&buffer, events, time.Date(2025, time.July, 22, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("WriteICal error: %v", err)
+	}
+	for _, line := range strings.Split(buffer.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line longer than 75 bytes: %q", line)
+		}
+	}
+
+	parsed, err := ParseICal(&buffer)
+	if err != nil {
+		t.Fatalf("ParseICal error: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, events) {
+		t.Errorf("round trip =\n%+v\nwant\n%+v", parsed, events)
+	}
+}
@@ -0,0 +1,527 @@
+package core
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// Campos de tarea que se pueden leer de una columna CSV
+const (
+	ImportID          = "id"
+	ImportDescription = "description"
+	ImportHours       = "hours"
+	ImportDuration    = "duration"
+	ImportCategory    = "category"
+	ImportDate        = "date"
+	ImportTaskDate    = "task_date"
+	ImportStatus      = "status"
+	ImportCreatedAt   = "created_at"
+	ImportProject     = "project"
+	ImportClient      = "client"
+	ImportTags        = "tags"
+	ImportBillable    = "billable"
+)
+
+// ImportFields devuelve los campos que se pueden asociar a columnas CSV
+func ImportFields() []string {
+	return []string{ImportID, ImportDescription, ImportHours, ImportDuration, ImportCategory, ImportDate,
+		ImportTaskDate, ImportStatus, ImportCreatedAt, ImportProject, ImportClient, ImportTags, ImportBillable}
+}
+
+// CSVMapping indica de qué columna se lee cada campo de tarea y cómo se interpretan los valores.
+// Las filas con el mismo ID son la misma tarea trabajada en varios días.
+type CSVMapping struct {
+	Columns      map[string]string // campo -> nombre de la columna
+	DateLayouts  []string          // formatos de fecha de Go probados en orden
+	TagSeparator string
+}
+
+// Formatos de archivo aceptados por la importación
+const (
+	ImportFormatCSV      = "csv"
+	ImportFormatJSON     = "json"
+	ImportFormatToggl    = "toggl-csv"
+	ImportFormatClockify = "clockify-csv"
+	ImportFormatICal     = "ics"
+)
+
+// defaultDateLayouts son los formatos de fecha probados cuando no se indica uno
+var defaultDateLayouts = []string{"2006-01-02", "01/02/2006", "02.01.2006", "2006/01/02"}
+
+// CSVMappingFor devuelve la asociación de columnas de un formato CSV conocido
+func CSVMappingFor(format string) (CSVMapping, error) {
+	switch format {
+	case ImportFormatCSV:
+		// Columnas escritas por 'workflow export --format csv'
+		return CSVMapping{
+			Columns: map[string]string{
+				ImportID:          "ID",
+				ImportDescription: "Description",
+				ImportHours:       "Hours",
+				ImportCategory:    "Category",
+				ImportDate:        "Date",
+				ImportTaskDate:    "Task Date",
+				ImportStatus:      "Status",
+				ImportCreatedAt:   "Created At",
+				ImportProject:     "Project",
+				ImportClient:      "Client",
+				ImportTags:        "Tags",
+			},
+			DateLayouts:  defaultDateLayouts,
+			TagSeparator: ";",
+		}, nil
+	case ImportFormatToggl:
+		// Reporte detallado de Toggl Track
+		return CSVMapping{
+			Columns: map[string]string{
+				ImportDescription: "Description",
+				ImportDuration:    "Duration",
+				ImportDate:        "Start date",
+				ImportProject:     "Project",
+				ImportClient:      "Client",
+				ImportTags:        "Tags",
+				ImportBillable:    "Billable",
+			},
+			DateLayouts:  defaultDateLayouts,
+			TagSeparator: ",",
+		}, nil
+	case ImportFormatClockify:
+		// Reporte detallado de Clockify
+		return CSVMapping{
+			Columns: map[string]string{
+				ImportDescription: "Description",
+				ImportHours:       "Duration (decimal)",
+				ImportDuration:    "Duration (h)",
+				ImportDate:        "Start Date",
+				ImportProject:     "Project",
+				ImportClient:      "Client",
+				ImportTags:        "Tags",
+				ImportBillable:    "Billable",
+			},
+			DateLayouts:  defaultDateLayouts,
+			TagSeparator: ",",
+		}, nil
+	}
+	return CSVMapping{}, fmt.Errorf("unsupported CSV format: %s", format)
+}
+
+// ParseDateLayout convierte un formato como "DD/MM/YYYY" en un formato de fecha de Go
+func ParseDateLayout(format string) (string, error) {
+	layout := strings.NewReplacer("YYYY", "2006", "MM", "01", "DD", "02").Replace(strings.ToUpper(format))
+	if !strings.Contains(layout, "2006") || !strings.Contains(layout, "01") || !strings.Contains(layout, "02") {
+		return "", fmt.Errorf("invalid date format: %s (use e.g. YYYY-MM-DD or DD/MM/YYYY)", format)
+	}
+	return layout, nil
+}
+
+// ReadCSVImport lee tareas de un CSV según la asociación de columnas.
+// defaultCategory se usa en las filas sin categoría; las filas sin estado quedan completadas.
+// validateCategory rechaza las categorías desconocidas indicando la fila.
+func ReadCSVImport(r io.Reader, mapping CSVMapping, defaultCategory string, validateCategory func(string) error) ([]ExportedTask, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("the CSV file is empty")
+		}
+		return nil, fmt.Errorf("could not read CSV header: %v", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+
+	index := make(map[string]int)
+	for field, column := range mapping.Columns {
+		if i, exists := columns[strings.ToLower(column)]; exists {
+			index[field] = i
+		}
+	}
+	if _, exists := index[ImportDescription]; !exists {
+		return nil, fmt.Errorf("column for %s not found in the CSV header (map it with --map %s=<column>)", ImportDescription, ImportDescription)
+	}
+	if _, exists := index[ImportDate]; !exists {
+		return nil, fmt.Errorf("column for %s not found in the CSV header (map it with --map %s=<column>)", ImportDate, ImportDate)
+	}
+	_, hasHours := index[ImportHours]
+	_, hasDuration := index[ImportDuration]
+	if !hasHours && !hasDuration {
+		return nil, fmt.Errorf("column for %s or %s not found in the CSV header (map one with --map)", ImportHours, ImportDuration)
+	}
+
+	var tasks []ExportedTask
+	byID := make(map[string]int)
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %v", row, err)
+		}
+
+		value := func(field string) string {
+			if i, exists := index[field]; exists && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		if strings.Join(record, "") == "" {
+			continue
+		}
+
+		task, err := parseCSVTask(value, mapping, defaultCategory)
+		if err == nil {
+			err = validateCategory(task.Category)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %v", row, err)
+		}
+
+		// La fecha de la fila es el día trabajado; la tarea conserva su propia fecha si el archivo la trae
+		entry := workflow.TimeEntry{Date: task.Date, Hours: task.Hours}
+		if taskDate := value(ImportTaskDate); taskDate != "" {
+			if task.Date, err = parseImportDate(taskDate, mapping.DateLayouts); err != nil {
+				return nil, fmt.Errorf("row %d: %v", row, err)
+			}
+		}
+
+		// Las filas con el mismo ID son días trabajados de una misma tarea, que toma los datos de la primera fila
+		if id := value(ImportID); id != "" {
+			if i, exists := byID[id]; exists {
+				tasks[i].Hours += task.Hours
+				tasks[i].TimeEntries = append(tasks[i].TimeEntries, entry)
+				continue
+			}
+			byID[id] = len(tasks)
+		}
+		tasks = append(tasks, ExportedTask{Task: task, TimeEntries: []workflow.TimeEntry{entry}})
+	}
+
+	return tasks, nil
+}
+
+// parseCSVTask arma una tarea con los valores de una fila
+func parseCSVTask(value func(string) string, mapping CSVMapping, defaultCategory string) (workflow.Task, error) {
+	task := workflow.Task{
+		Description: value(ImportDescription),
+		Category:    value(ImportCategory),
+		Status:      value(ImportStatus),
+		Project:     value(ImportProject),
+		Client:      value(ImportClient),
+	}
+	if task.Description == "" {
+		return task, fmt.Errorf("empty description")
+	}
+	if task.Category == "" {
+		task.Category = defaultCategory
+	}
+	if task.Status == "" {
+		task.Status = workflow.StatusCompleted
+	}
+	if !isValidStatus(task.Status) {
+		return task, fmt.Errorf("invalid status '%s' (use %s)", task.Status, strings.Join(validStatuses(), ", "))
+	}
+
+	date, err := parseImportDate(value(ImportDate), mapping.DateLayouts)
+	if err != nil {
+		return task, err
+	}
+	task.Date = date
+
+	if hours := value(ImportHours); hours != "" {
+		if task.Hours, err = strconv.ParseFloat(strings.Replace(hours, ",", ".", 1), 64); err != nil {
+			return task, fmt.Errorf("invalid hours: %s", hours)
+		}
+	} else if duration := value(ImportDuration); duration != "" {
+		if task.Hours, err = parseClockDuration(duration); err != nil {
+			return task, err
+		}
+	}
+	if task.Hours < 0 {
+		return task, fmt.Errorf("hours cannot be negative")
+	}
+
+	if tags := value(ImportTags); tags != "" {
+		if task.Tags, err = NormalizeTags(strings.Split(tags, mapping.TagSeparator)); err != nil {
+			return task, err
+		}
+	}
+
+	if billable := value(ImportBillable); billable != "" {
+		switch strings.ToLower(billable) {
+		case "yes", "true", "1":
+			value := true
+			task.Billable = &value
+		case "no", "false", "0":
+			value := false
+			task.Billable = &value
+		default:
+			return task, fmt.Errorf("invalid billable value: %s", billable)
+		}
+	}
+
+	task.CreatedAt = time.Now().UTC()
+	if createdAt := value(ImportCreatedAt); createdAt != "" {
+		// Las exportaciones CSV escriben created_at en UTC sin zona
+		parsed, err := time.Parse("2006-01-02 15:04:05", createdAt)
+		if err != nil {
+			if parsed, err = time.Parse(time.RFC3339Nano, createdAt); err != nil {
+				return task, fmt.Errorf("invalid created at: %s", createdAt)
+			}
+		}
+		task.CreatedAt = parsed.UTC()
+	}
+
+	return task, nil
+}
+
+// parseImportDate interpreta una fecha con el primer formato que coincida; acepta fecha y hora
+func parseImportDate(value string, layouts []string) (string, error) {
+	if value == "" {
+		return "", fmt.Errorf("empty date")
+	}
+	date, _, _ := strings.Cut(value, " ")
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t.Format("2006-01-02"), nil
+		}
+	}
+	return "", fmt.Errorf("invalid date: %s (set the format with --date-format)", value)
+}
+
+// parseClockDuration interpreta una duración como "1:30:00" o "01:30"
+func parseClockDuration(value string) (float64, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid duration: %s (expected HH:MM:SS)", value)
+	}
+
+	var hours float64
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid duration: %s (expected HH:MM:SS)", value)
+		}
+		hours += float64(n) / math.Pow(60, float64(i))
+	}
+	return hours, nil
+}
+
+// ReadJSONExport lee un archivo de 'workflow export --format json' tarea por tarea.
+// También acepta la lista de tareas escrita por versiones anteriores.
+func ReadJSONExport(r io.Reader) ([]ExportedTask, error) {
+	decoder := json.NewDecoder(r)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, fmt.Errorf("could not read JSON export: %v", err)
+	}
+
+	switch token {
+	case json.Delim('['):
+		return readLegacyJSONTasks(decoder)
+	case json.Delim('{'):
+	default:
+		return nil, fmt.Errorf("unexpected JSON export content")
+	}
+
+	var tasks []ExportedTask
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("could not read JSON export: %v", err)
+		}
+
+		switch key {
+		case "schema_version":
+			var version int
+			if err := decoder.Decode(&version); err != nil {
+				return nil, fmt.Errorf("invalid schema_version: %v", err)
+			}
+			if version < 1 || version > ExportSchemaVersion {
+				return nil, fmt.Errorf("unsupported export schema version %d (this version reads up to %d)", version, ExportSchemaVersion)
+			}
+		case "tasks":
+			if _, err := decoder.Token(); err != nil {
+				return nil, fmt.Errorf("could not read tasks: %v", err)
+			}
+			for decoder.More() {
+				var task ExportedTask
+				if err := decoder.Decode(&task); err != nil {
+					return nil, fmt.Errorf("could not decode task %d: %v", len(tasks)+1, err)
+				}
+				tasks = append(tasks, task)
+			}
+			if _, err := decoder.Token(); err != nil {
+				return nil, fmt.Errorf("could not read tasks: %v", err)
+			}
+		default:
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return nil, fmt.Errorf("could not read JSON export: %v", err)
+			}
+		}
+	}
+
+	return tasks, nil
+}
+
+// readLegacyJSONTasks lee la lista de tareas de las exportaciones sin versión,
+// donde created_at usaba el formato "2006-01-02 15:04:05" en UTC
+func readLegacyJSONTasks(decoder *json.Decoder) ([]ExportedTask, error) {
+	var tasks []ExportedTask
+	for decoder.More() {
+		var legacy struct {
+			workflow.Task
+			CreatedAt string `json:"created_at"`
+		}
+		if err := decoder.Decode(&legacy); err != nil {
+			return nil, fmt.Errorf("could not decode task %d: %v", len(tasks)+1, err)
+		}
+
+		task := ExportedTask{Task: legacy.Task}
+		task.CreatedAt = time.Now().UTC()
+		if createdAt, err := time.Parse("2006-01-02 15:04:05", legacy.CreatedAt); err == nil {
+			task.CreatedAt = createdAt.UTC()
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// ICalImportTasks convierte los eventos de un calendario en tareas completadas de la categoría indicada,
+// con las horas entre DTSTART y DTEND. Devuelve también cuántos eventos sin duración
+// (de día completo o sin DTEND) se omitieron.
+func ICalImportTasks(events []ICalEvent, category string) ([]ExportedTask, int) {
+	var tasks []ExportedTask
+	skipped := 0
+	for _, event := range events {
+		if event.AllDay || !event.End.After(event.Start) {
+			skipped++
+			continue
+		}
+
+		start := event.Start.In(Location())
+		entry := workflow.TimeEntry{
+			Date:  start.Format("2006-01-02"),
+			Start: event.Start.UTC(),
+			End:   event.End.UTC(),
+			Hours: math.Round(event.End.Sub(event.Start).Hours()*100) / 100,
+		}
+		description := strings.TrimSpace(event.Summary)
+		if description == "" {
+			description = "(no title)"
+		}
+
+		tasks = append(tasks, ExportedTask{
+			Task: workflow.Task{
+				Description: description,
+				Hours:       entry.Hours,
+				Category:    category,
+				Date:        entry.Date,
+				Status:      workflow.StatusCompleted,
+				CreatedAt:   event.Start.UTC(),
+			},
+			TimeEntries: []workflow.TimeEntry{entry},
+		})
+	}
+	return tasks, skipped
+}
+
+// DuplicateKey identifica una tarea por fecha, descripción y horas para detectar duplicados
+func DuplicateKey(task workflow.Task) string {
+	return fmt.Sprintf("%s|%s|%.2f", task.Date, strings.ToLower(strings.TrimSpace(task.Description)), task.Hours)
+}
+
+// ImportTasks guarda las tareas importadas en una única transacción.
+// Los proyectos que no existen se crean con su cliente y las tareas reciben IDs nuevos.
+func (dm *DatabaseManager) ImportTasks(tasks []ExportedTask) error {
+	return dm.withTx(func(tx *sql.Tx) error {
+		for i := range tasks {
+			task := &tasks[i]
+			if err := ensureProject(tx, task.Project, task.Client); err != nil {
+				return err
+			}
+			if err := insertTask(tx, &task.Task); err != nil {
+				return err
+			}
+			if len(task.TimeEntries) == 0 {
+				continue
+			}
+
+			// Reemplazar el registro de horas inicial por los registros importados
+			if _, err := tx.Exec(`DELETE FROM time_entries WHERE task_id = ?`, task.ID); err != nil {
+				return fmt.Errorf("could not replace time entries: %v", err)
+			}
+			for j := range task.TimeEntries {
+				entry := &task.TimeEntries[j]
+				entry.ID = 0
+				entry.TaskID = task.ID
+				if err := insertTimeEntry(tx, entry); err != nil {
+					return err
+				}
+			}
+			if err := refreshTaskHours(tx, task.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ensureProject crea un proyecto con su cliente si todavía no existe
+func ensureProject(tx *sql.Tx, name string, client string) error {
+	if name == "" {
+		return nil
+	}
+
+	var id int64
+	err := tx.QueryRow(`SELECT id FROM projects WHERE name = ?`, name).Scan(&id)
+	if err == nil {
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		return fmt.Errorf("could not query project: %v", err)
+	}
+
+	var clientID interface{}
+	if client != "" {
+		id, err := ensureClient(tx, client)
+		if err != nil {
+			return err
+		}
+		clientID = id
+	}
+	if _, err := tx.Exec(`INSERT INTO projects (name, client_id) VALUES (?, ?)`, name, clientID); err != nil {
+		return fmt.Errorf("could not insert project: %v", err)
+	}
+	return nil
+}
+
+// ImportTasks guarda las tareas importadas en una única transacción
+func (tm *TaskManagerSQLite) ImportTasks(tasks []ExportedTask) error {
+	return tm.dbManager.ImportTasks(tasks)
+}
+
+// ImportTasks agrega las tareas importadas y guarda el archivo una sola vez.
+// El backend JSON no guarda registros de horas, así que cada tarea conserva solo su total.
+func (tm *TaskManager) ImportTasks(tasks []ExportedTask) error {
+	batch := TaskBatch{}
+	for i := range tasks {
+		batch.Create = append(batch.Create, &tasks[i].Task)
+	}
+	return tm.ApplyBatch(batch)
+}
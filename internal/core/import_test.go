@@ -0,0 +1,219 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// testCategories valida las categorías como lo hace la configuración por defecto
+func testCategories(category string) error {
+	switch category {
+	case "tech", "meeting", "doc":
+		return nil
+	}
+	return fmt.Errorf("unknown category '%s'", category)
+}
+
+// TestReadCSVImport comprueba la lectura de los formatos CSV conocidos y de una asociación de columnas propia
+func TestReadCSVImport(t *testing.T) {
+	yes, no := true, false
+	customMapping := CSVMapping{
+		Columns:     map[string]string{ImportDescription: "Task", ImportHours: "Time", ImportDate: "Day", ImportCategory: "Type"},
+		DateLayouts: []string{"02/01/2006"},
+	}
+
+	tests := []struct {
+		name    string
+		format  string
+		mapping *CSVMapping
+		input   string
+		want    []ExportedTask
+	}{
+		{
+			name:   "workflow export with a task worked on two days",
+			format: ImportFormatCSV,
+			input: "ID,Description,Hours,Category,Date,Status,Created At,Project,Client,Tags,Task Date\n" +
+				"7,\"API, v2\",1.5,tech,2025-07-22,completed,2025-07-20 22:30:00,Web,Acme,urgent;backend,2025-07-22\n" +
+				"7,\"API, v2\",0.25,tech,2025-07-21,completed,2025-07-20 22:30:00,Web,Acme,urgent;backend,2025-07-22\n" +
+				"8,Standup,0.25,meeting,2025-07-22,pending,2025-07-22 09:00:00,,,,2025-07-22\n",
+			want: []ExportedTask{
+				{
+					Task: workflow.Task{Description: "API, v2", Hours: 1.75, Category: "tech", Date: "2025-07-22", Status: workflow.StatusCompleted,
+						CreatedAt: time.Date(2025, time.July, 20, 22, 30, 0, 0, time.UTC), Project: "Web", Client: "Acme", Tags: []string{"backend", "urgent"}},
+					TimeEntries: []workflow.TimeEntry{{Date: "2025-07-22", Hours: 1.5}, {Date: "2025-07-21", Hours: 0.25}},
+				},
+				{
+					Task: workflow.Task{Description: "Standup", Hours: 0.25, Category: "meeting", Date: "2025-07-22", Status: workflow.StatusPending,
+						CreatedAt: time.Date(2025, time.July, 22, 9, 0, 0, 0, time.UTC)},
+					TimeEntries: []workflow.TimeEntry{{Date: "2025-07-22", Hours: 0.25}},
+				},
+			},
+		},
+		{
+			name:   "rows without a task date keep the date of the first row",
+			format: ImportFormatCSV,
+			input: "ID,Description,Hours,Date\n" +
+				"9,Review,0.25,2025-07-23\n" +
+				"9,Review,0.5,2025-07-21\n",
+			want: []ExportedTask{{
+				Task:        workflow.Task{Description: "Review", Hours: 0.75, Category: "tech", Date: "2025-07-23", Status: workflow.StatusCompleted},
+				TimeEntries: []workflow.TimeEntry{{Date: "2025-07-23", Hours: 0.25}, {Date: "2025-07-21", Hours: 0.5}},
+			}},
+		},
+		{
+			name:   "toggl duration and billable",
+			format: ImportFormatToggl,
+			input: "Description,Duration,Start date,Project,Client,Tags,Billable\n" +
+				"Review,01:30:00,2025-07-21,Web,Acme,\"qa, ui\",Yes\n",
+			want: []ExportedTask{{
+				Task: workflow.Task{Description: "Review", Hours: 1.5, Category: "tech", Date: "2025-07-21", Status: workflow.StatusCompleted,
+					Project: "Web", Client: "Acme", Tags: []string{"qa", "ui"}, Billable: &yes},
+				TimeEntries: []workflow.TimeEntry{{Date: "2025-07-21", Hours: 1.5}},
+			}},
+		},
+		{
+			name:   "clockify decimal hours with a comma",
+			format: ImportFormatClockify,
+			input: "Description,Duration (decimal),Duration (h),Start Date,Billable\n" +
+				"Docs,\"2,5\",02:30:00,07/21/2025,No\n",
+			want: []ExportedTask{{
+				Task:        workflow.Task{Description: "Docs", Hours: 2.5, Category: "tech", Date: "2025-07-21", Status: workflow.StatusCompleted, Billable: &no},
+				TimeEntries: []workflow.TimeEntry{{Date: "2025-07-21", Hours: 2.5}},
+			}},
+		},
+		{
+			name:    "custom mapping with byte order mark and blank rows",
+			mapping: &customMapping,
+			input:   "\ufeffTask,Time,Day,Type\n,,,\nPlan,1.333,21/07/2025 10:00,meeting\n",
+			want: []ExportedTask{{
+				Task:        workflow.Task{Description: "Plan", Hours: 1.333, Category: "meeting", Date: "2025-07-21", Status: workflow.StatusCompleted},
+				TimeEntries: []workflow.TimeEntry{{Date: "2025-07-21", Hours: 1.333}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping := customMapping
+			if tt.mapping == nil {
+				var err error
+				if mapping, err = CSVMappingFor(tt.format); err != nil {
+					t.Fatalf("CSVMappingFor(%s) error: %v", tt.format, err)
+				}
+			}
+
+			tasks, err := ReadCSVImport(strings.NewReader(tt.input), mapping, "tech", testCategories)
+			if err != nil {
+				t.Fatalf("ReadCSVImport error: %v", err)
+			}
+
+			// Sin columna de creación se usa la hora de la importación
+			for i := range tasks {
+				if i < len(tt.want) && tt.want[i].CreatedAt.IsZero() {
+					if time.Since(tasks[i].CreatedAt) > time.Minute {
+						t.Errorf("task %d created at %v, want the import time", i, tasks[i].CreatedAt)
+					}
+					tasks[i].CreatedAt = time.Time{}
+				}
+			}
+			if !reflect.DeepEqual(tasks, tt.want) {
+				t.Errorf("ReadCSVImport =\n%+v\nwant\n%+v", tasks, tt.want)
+			}
+		})
+	}
+}
+
+// TestReadCSVImportInvalid comprueba que los errores indiquen el problema de cada archivo
+func TestReadCSVImportInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  string
+		want   string
+	}{
+		{name: "empty file", format: ImportFormatCSV, input: "", want: "empty"},
+		{name: "missing description column", format: ImportFormatCSV, input: "Hours,Date\n1,2025-07-21\n", want: "description"},
+		{name: "missing date column", format: ImportFormatCSV, input: "Description,Hours\nA,1\n", want: "date"},
+		{name: "missing hours column", format: ImportFormatCSV, input: "Description,Date\nA,2025-07-21\n", want: "hours"},
+		{name: "invalid hours", format: ImportFormatCSV, input: "Description,Hours,Date\nA,abc,2025-07-21\n", want: "row 2: invalid hours"},
+		{name: "negative hours", format: ImportFormatCSV, input: "Description,Hours,Date\nA,-1,2025-07-21\n", want: "negative"},
+		{name: "unknown category", format: ImportFormatCSV, input: "Description,Hours,Date,Category\nA,1,2025-07-21,sales\n", want: "unknown category"},
+		{name: "invalid status", format: ImportFormatCSV, input: "Description,Hours,Date,Status\nA,1,2025-07-21,done\n", want: "invalid status"},
+		{name: "invalid date", format: ImportFormatCSV, input: "Description,Hours,Date\nA,1,21st July\n", want: "invalid date"},
+		{name: "empty description", format: ImportFormatCSV, input: "Description,Hours,Date\n,1,2025-07-21\nB,1,2025-07-21\n", want: "row 2: empty description"},
+		{name: "invalid duration", format: ImportFormatToggl, input: "Description,Duration,Start date\nA,1h30,2025-07-21\n", want: "invalid duration"},
+		{name: "invalid billable", format: ImportFormatToggl, input: "Description,Duration,Start date,Billable\nA,1:00,2025-07-21,maybe\n", want: "invalid billable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mapping, err := CSVMappingFor(tt.format)
+			if err != nil {
+				t.Fatalf("CSVMappingFor(%s) error: %v", tt.format, err)
+			}
+			_, err = ReadCSVImport(strings.NewReader(tt.input), mapping, "tech", testCategories)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ReadCSVImport error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// TestDuplicateKey comprueba qué tareas se consideran la misma al importar
+func TestDuplicateKey(t *testing.T) {
+	base := workflow.Task{Description: "Code review", Hours: 0.25, Date: "2025-07-21", Category: "tech"}
+
+	tests := []struct {
+		name  string
+		task  workflow.Task
+		equal bool
+	}{
+		{name: "same task", task: base, equal: true},
+		{name: "case and spaces", task: workflow.Task{Description: "  code REVIEW ", Hours: 0.25, Date: "2025-07-21"}, equal: true},
+		{name: "other category and status", task: workflow.Task{Description: "Code review", Hours: 0.25, Date: "2025-07-21", Category: "qa", Status: workflow.StatusPending}, equal: true},
+		{name: "hours below a cent", task: workflow.Task{Description: "Code review", Hours: 0.251, Date: "2025-07-21"}, equal: true},
+		{name: "other hours", task: workflow.Task{Description: "Code review", Hours: 0.5, Date: "2025-07-21"}, equal: false},
+		{name: "other date", task: workflow.Task{Description: "Code review", Hours: 0.25, Date: "2025-07-22"}, equal: false},
+		{name: "other description", task: workflow.Task{Description: "Code reviews", Hours: 0.25, Date: "2025-07-21"}, equal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if equal := DuplicateKey(tt.task) == DuplicateKey(base); equal != tt.equal {
+				t.Errorf("DuplicateKey(%+v) == DuplicateKey(base) is %v, want %v", tt.task, equal, tt.equal)
+			}
+		})
+	}
+}
+
+// TestParseDateLayout comprueba la conversión de formatos de fecha legibles
+func TestParseDateLayout(t *testing.T) {
+	tests := []struct {
+		format  string
+		want    string
+		wantErr bool
+	}{
+		{format: "YYYY-MM-DD", want: "2006-01-02"},
+		{format: "dd/mm/yyyy", want: "02/01/2006"},
+		{format: "MM.DD.YYYY", want: "01.02.2006"},
+		{format: "DD/MM", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDateLayout(tt.format)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseDateLayout(%q) = %q, want error", tt.format, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseDateLayout(%q) = %q, %v, want %q", tt.format, got, err, tt.want)
+		}
+	}
+}
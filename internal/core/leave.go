@@ -0,0 +1,167 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// AddLeave guarda una ausencia
+func (dm *DatabaseManager) AddLeave(leave *workflow.Leave) error {
+	result, err := dm.db.Exec(`
+	INSERT INTO leave (start_date, end_date, type, hours, note)
+	VALUES (?, ?, ?, ?, ?)
+	`, leave.From, leave.To, leave.Type, leave.Hours, leave.Note)
+	if err != nil {
+		return fmt.Errorf("could not insert leave: %v", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("could not get last insert id: %v", err)
+	}
+
+	leave.ID = int(id)
+	return nil
+}
+
+// ListLeave devuelve las ausencias que se superponen con el rango indicado; un límite vacío no limita
+func (dm *DatabaseManager) ListLeave(from string, to string) ([]workflow.Leave, error) {
+	query := `SELECT id, start_date, end_date, type, hours, note, created_at FROM leave WHERE 1 = 1`
+	var args []interface{}
+
+	if from != "" {
+		query += ` AND end_date >= ?`
+		args = append(args, from)
+	}
+	if to != "" {
+		query += ` AND start_date <= ?`
+		args = append(args, to)
+	}
+	query += ` ORDER BY start_date, id`
+
+	rows, err := dm.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not query leave: %v", err)
+	}
+	defer rows.Close()
+
+	var leaves []workflow.Leave
+	for rows.Next() {
+		var leave workflow.Leave
+		var createdAt interface{}
+		if err := rows.Scan(&leave.ID, &leave.From, &leave.To, &leave.Type, &leave.Hours, &leave.Note, &createdAt); err != nil {
+			return nil, fmt.Errorf("could not scan leave: %v", err)
+		}
+		leave.CreatedAt = parseTimestamp(createdAt)
+		leaves = append(leaves, leave)
+	}
+
+	return leaves, rows.Err()
+}
+
+// RemoveLeave elimina una ausencia
+func (dm *DatabaseManager) RemoveLeave(id int) error {
+	result, err := dm.db.Exec(`DELETE FROM leave WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("could not delete leave: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("could not get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("leave with ID %d not found", id)
+	}
+
+	return nil
+}
+
+// AddLeave valida y guarda una ausencia; no puede superponerse con otra ya registrada
+func (tm *TaskManagerSQLite) AddLeave(leave *workflow.Leave) error {
+	if !isValidLeaveType(leave.Type) {
+		return fmt.Errorf("invalid leave type '%s' (use %s)", leave.Type, strings.Join(leaveTypes(), ", "))
+	}
+	if leave.To == "" {
+		leave.To = leave.From
+	}
+	for _, date := range []string{leave.From, leave.To} {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return fmt.Errorf("invalid date format: %s (use YYYY-MM-DD)", date)
+		}
+	}
+	if leave.From > leave.To {
+		return fmt.Errorf("leave start (%s) must not be after its end (%s)", leave.From, leave.To)
+	}
+	if leave.Hours < 0 || leave.Hours > 24 {
+		return fmt.Errorf("leave hours must be between 0 and 24")
+	}
+
+	overlapping, err := tm.dbManager.ListLeave(leave.From, leave.To)
+	if err != nil {
+		return err
+	}
+	if len(overlapping) > 0 {
+		other := overlapping[0]
+		return fmt.Errorf("leave overlaps with [%d] %s from %s to %s", other.ID, other.Type, other.From, other.To)
+	}
+
+	return tm.dbManager.AddLeave(leave)
+}
+
+// ListLeave devuelve las ausencias que se superponen con el rango indicado
+func (tm *TaskManagerSQLite) ListLeave(from string, to string) ([]workflow.Leave, error) {
+	return tm.dbManager.ListLeave(from, to)
+}
+
+// RemoveLeave elimina una ausencia por ID
+func (tm *TaskManagerSQLite) RemoveLeave(id int) error {
+	return tm.dbManager.RemoveLeave(id)
+}
+
+// GetLeaveAllowance devuelve los días de ausencia por año configurados para cada tipo
+func (cm *ConfigManager) GetLeaveAllowance() map[string]float64 {
+	return cm.config.LeaveAllowance
+}
+
+// SetLeaveAllowance guarda los días de ausencia por año de un tipo; cero quita el límite
+func (cm *ConfigManager) SetLeaveAllowance(leaveType string, days float64) error {
+	if !isValidLeaveType(leaveType) {
+		return fmt.Errorf("invalid leave type '%s' (use %s)", leaveType, strings.Join(leaveTypes(), ", "))
+	}
+	if days < 0 {
+		return fmt.Errorf("allowance cannot be negative")
+	}
+
+	if cm.config.LeaveAllowance == nil {
+		cm.config.LeaveAllowance = make(map[string]float64)
+	}
+	if days == 0 {
+		delete(cm.config.LeaveAllowance, leaveType)
+	} else {
+		cm.config.LeaveAllowance[leaveType] = days
+	}
+
+	if err := cm.Save(); err != nil {
+		return fmt.Errorf("could not save config: %v", err)
+	}
+	return nil
+}
+
+// isValidLeaveType indica si un tipo de ausencia es uno de los conocidos
+func isValidLeaveType(leaveType string) bool {
+	for _, validType := range leaveTypes() {
+		if leaveType == validType {
+			return true
+		}
+	}
+	return false
+}
+
+// leaveTypes devuelve la lista de tipos de ausencia válidos
+func leaveTypes() []string {
+	return []string{workflow.LeaveVacation, workflow.LeaveSick, workflow.LeaveHoliday}
+}
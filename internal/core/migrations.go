@@ -0,0 +1,304 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Migration representa un cambio versionado del esquema de la base de datos
+type Migration struct {
+	Version     int
+	Description string
+	Up          string
+}
+
+// MigrationStatus describe si una migración fue aplicada y cuándo
+type MigrationStatus struct {
+	Version     int
+	Description string
+	Applied     bool
+	AppliedAt   time.Time
+}
+
+// migrations es la lista ordenada de migraciones del esquema.
+// Nunca se deben modificar migraciones existentes: los cambios nuevos se
+// agregan al final con la siguiente versión.
+var migrations = []Migration{
+	{
+		Version:     1,
+		Description: "create tasks table",
+		Up: `
+		CREATE TABLE IF NOT EXISTS tasks (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			description TEXT NOT NULL,
+			hours REAL NOT NULL,
+			category TEXT DEFAULT 'general',
+			date TEXT NOT NULL,
+			status TEXT DEFAULT 'pending',
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_tasks_date ON tasks(date);
+		CREATE INDEX IF NOT EXISTS idx_tasks_category ON tasks(category);
+		CREATE INDEX IF NOT EXISTS idx_tasks_status ON tasks(status);
+		CREATE INDEX IF NOT EXISTS idx_tasks_description ON tasks(description);
+		`,
+	},
+	{
+		Version:     2,
+		Description: "index tasks by date range",
+		Up: `
+		CREATE INDEX IF NOT EXISTS idx_tasks_date_category_status ON tasks(date, category, status);
+		`,
+	},
+	{
+		Version:     3,
+		Description: "create timers table",
+		Up: `
+		CREATE TABLE IF NOT EXISTS timers (
+			id INTEGER PRIMARY KEY CHECK (id = 1),
+			description TEXT NOT NULL,
+			category TEXT DEFAULT 'general',
+			task_id INTEGER REFERENCES tasks(id) ON DELETE SET NULL,
+			started_at TEXT NOT NULL,
+			resumed_at TEXT NOT NULL,
+			accumulated_seconds REAL NOT NULL DEFAULT 0,
+			paused INTEGER NOT NULL DEFAULT 0
+		);
+		`,
+	},
+	{
+		Version:     4,
+		Description: "create time_entries table",
+		Up: `
+		CREATE TABLE IF NOT EXISTS time_entries (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+			date TEXT NOT NULL,
+			start_time TEXT,
+			end_time TEXT,
+			hours REAL NOT NULL,
+			note TEXT DEFAULT '',
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_time_entries_task ON time_entries(task_id);
+		CREATE INDEX IF NOT EXISTS idx_time_entries_date ON time_entries(date);
+
+		INSERT INTO time_entries (task_id, date, hours)
+		SELECT id, date, hours FROM tasks WHERE hours <> 0;
+		`,
+	},
+	{
+		Version:     5,
+		Description: "create projects and clients",
+		Up: `
+		CREATE TABLE IF NOT EXISTS clients (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE IF NOT EXISTS projects (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL UNIQUE,
+			client_id INTEGER REFERENCES clients(id),
+			archived INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		);
+
+		ALTER TABLE tasks ADD COLUMN project_id INTEGER REFERENCES projects(id);
+
+		CREATE INDEX IF NOT EXISTS idx_tasks_project ON tasks(project_id);
+		CREATE INDEX IF NOT EXISTS idx_projects_client ON projects(client_id);
+		`,
+	},
+	{
+		Version:     6,
+		Description: "create task_tags table",
+		Up: `
+		CREATE TABLE IF NOT EXISTS task_tags (
+			task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+			tag TEXT NOT NULL,
+			PRIMARY KEY (task_id, tag)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_task_tags_tag ON task_tags(tag);
+		`,
+	},
+	{
+		Version:     7,
+		Description: "create recurring templates",
+		Up: `
+		CREATE TABLE IF NOT EXISTS recurring_templates (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			description TEXT NOT NULL,
+			hours REAL NOT NULL,
+			category TEXT DEFAULT 'general',
+			rule TEXT NOT NULL,
+			start_date TEXT NOT NULL,
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE TABLE IF NOT EXISTS recurring_instances (
+			template_id INTEGER NOT NULL REFERENCES recurring_templates(id) ON DELETE CASCADE,
+			date TEXT NOT NULL,
+			task_id INTEGER REFERENCES tasks(id) ON DELETE SET NULL,
+			PRIMARY KEY (template_id, date)
+		);
+		`,
+	},
+	{
+		Version:     8,
+		Description: "create task_history table",
+		Up: `
+		CREATE TABLE IF NOT EXISTS task_history (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			task_id INTEGER NOT NULL,
+			operation TEXT NOT NULL,
+			before_json TEXT,
+			after_json TEXT,
+			time_entries_json TEXT,
+			entry_id INTEGER,
+			reverts_id INTEGER REFERENCES task_history(id),
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_task_history_task ON task_history(task_id);
+		CREATE INDEX IF NOT EXISTS idx_task_history_reverts ON task_history(reverts_id);
+		`,
+	},
+	{
+		Version:     9,
+		Description: "add deleted_at to tasks for the trash",
+		Up: `
+		ALTER TABLE tasks ADD COLUMN deleted_at TIMESTAMP;
+
+		CREATE INDEX IF NOT EXISTS idx_tasks_deleted_at ON tasks(deleted_at);
+		`,
+	},
+	{
+		Version:     10,
+		Description: "create leave table",
+		Up: `
+		CREATE TABLE IF NOT EXISTS leave (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			start_date TEXT NOT NULL,
+			end_date TEXT NOT NULL,
+			type TEXT NOT NULL,
+			hours REAL NOT NULL DEFAULT 0,
+			note TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_leave_dates ON leave(start_date, end_date);
+		`,
+	},
+	{
+		Version:     11,
+		Description: "add billable flag to tasks",
+		Up: `
+		ALTER TABLE tasks ADD COLUMN billable INTEGER;
+		`,
+	},
+}
+
+// createSchemaVersionTable crea la tabla que registra las migraciones aplicadas
+func (dm *DatabaseManager) createSchemaVersionTable() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS schema_version (
+		version INTEGER PRIMARY KEY,
+		description TEXT NOT NULL,
+		applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	);
+	`
+
+	_, err := dm.db.Exec(query)
+	return err
+}
+
+// migrate aplica en orden todas las migraciones pendientes
+func (dm *DatabaseManager) migrate() error {
+	if err := dm.createSchemaVersionTable(); err != nil {
+		return fmt.Errorf("could not create schema_version table: %v", err)
+	}
+
+	current, err := dm.SchemaVersion()
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range migrations {
+		if migration.Version <= current {
+			continue
+		}
+		if err := dm.applyMigration(migration); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %v", migration.Version, migration.Description, err)
+		}
+	}
+
+	return nil
+}
+
+// applyMigration ejecuta una migración y la registra dentro de una transacción
+func (dm *DatabaseManager) applyMigration(migration Migration) error {
+	tx, err := dm.db.Begin()
+	if err != nil {
+		return fmt.Errorf("could not begin transaction: %v", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(migration.Up); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`INSERT INTO schema_version (version, description) VALUES (?, ?)`,
+		migration.Version, migration.Description); err != nil {
+		return fmt.Errorf("could not record migration: %v", err)
+	}
+
+	return tx.Commit()
+}
+
+// SchemaVersion devuelve la versión más alta de esquema aplicada
+func (dm *DatabaseManager) SchemaVersion() (int, error) {
+	var version sql.NullInt64
+	if err := dm.db.QueryRow(`SELECT MAX(version) FROM schema_version`).Scan(&version); err != nil {
+		return 0, fmt.Errorf("could not read schema version: %v", err)
+	}
+	return int(version.Int64), nil
+}
+
+// MigrationStatus devuelve el estado de cada migración conocida
+func (dm *DatabaseManager) MigrationStatus() ([]MigrationStatus, error) {
+	rows, err := dm.db.Query(`SELECT version, applied_at FROM schema_version`)
+	if err != nil {
+		return nil, fmt.Errorf("could not query schema_version: %v", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("could not scan schema_version: %v", err)
+		}
+		applied[version] = appliedAt
+	}
+
+	var statuses []MigrationStatus
+	for _, migration := range migrations {
+		appliedAt, ok := applied[migration.Version]
+		statuses = append(statuses, MigrationStatus{
+			Version:     migration.Version,
+			Description: migration.Description,
+			Applied:     ok,
+			AppliedAt:   appliedAt,
+		})
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,186 @@
+package core
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+)
+
+// openTestDatabase abre una base de datos vacía en un directorio temporal
+func openTestDatabase(t *testing.T) *DatabaseManager {
+	t.Helper()
+	dm := NewDatabaseManager(t.TempDir())
+	db, err := sql.Open("sqlite3", dm.dataSourceName())
+	if err != nil {
+		t.Fatalf("could not open database: %v", err)
+	}
+	dm.db = db
+	t.Cleanup(func() { dm.Close() })
+	return dm
+}
+
+// migrateTo aplica las migraciones hasta la versión indicada, inclusive
+func migrateTo(t *testing.T, dm *DatabaseManager, version int) {
+	t.Helper()
+	if err := dm.createSchemaVersionTable(); err != nil {
+		t.Fatalf("createSchemaVersionTable error: %v", err)
+	}
+	current, err := dm.SchemaVersion()
+	if err != nil {
+		t.Fatalf("SchemaVersion error: %v", err)
+	}
+	for _, migration := range migrations {
+		if migration.Version > current && migration.Version <= version {
+			if err := dm.applyMigration(migration); err != nil {
+				t.Fatalf("migration %d failed: %v", migration.Version, err)
+			}
+		}
+	}
+}
+
+// schemaHas indica si existe una tabla o índice, o una columna si column no está vacío
+func schemaHas(t *testing.T, dm *DatabaseManager, kind string, name string, column string) bool {
+	t.Helper()
+	var count int
+	var err error
+	if column != "" {
+		err = dm.db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, name, column).Scan(&count)
+	} else {
+		err = dm.db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = ? AND name = ?`, kind, name).Scan(&count)
+	}
+	if err != nil {
+		t.Fatalf("could not inspect schema: %v", err)
+	}
+	return count > 0
+}
+
+// TestMigrationVersions comprueba que las versiones sean consecutivas desde 1
+func TestMigrationVersions(t *testing.T) {
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			t.Errorf("migration %d has version %d, want %d", i, migration.Version, i+1)
+		}
+		if migration.Description == "" {
+			t.Errorf("migration %d has no description", migration.Version)
+		}
+	}
+}
+
+// TestMigrations comprueba que cada migración cree su parte del esquema y que no exista antes
+func TestMigrations(t *testing.T) {
+	tests := []struct {
+		version int
+		kind    string
+		name    string
+		column  string
+	}{
+		{version: 1, kind: "table", name: "tasks"},
+		{version: 1, kind: "index", name: "idx_tasks_date"},
+		{version: 2, kind: "index", name: "idx_tasks_date_category_status"},
+		{version: 3, kind: "table", name: "timers"},
+		{version: 4, kind: "table", name: "time_entries"},
+		{version: 4, kind: "index", name: "idx_time_entries_date"},
+		{version: 5, kind: "table", name: "clients"},
+		{version: 5, kind: "table", name: "projects"},
+		{version: 5, kind: "column", name: "tasks", column: "project_id"},
+		{version: 6, kind: "table", name: "task_tags"},
+		{version: 7, kind: "table", name: "recurring_templates"},
+		{version: 7, kind: "table", name: "recurring_instances"},
+		{version: 8, kind: "table", name: "task_history"},
+		{version: 9, kind: "column", name: "tasks", column: "deleted_at"},
+		{version: 10, kind: "table", name: "leave"},
+		{version: 11, kind: "column", name: "tasks", column: "billable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" "+tt.column, func(t *testing.T) {
+			dm := openTestDatabase(t)
+
+			migrateTo(t, dm, tt.version-1)
+			if tt.version > 1 && schemaHas(t, dm, tt.kind, tt.name, tt.column) {
+				t.Errorf("%s %s %s exists before migration %d", tt.kind, tt.name, tt.column, tt.version)
+			}
+
+			migrateTo(t, dm, tt.version)
+			if !schemaHas(t, dm, tt.kind, tt.name, tt.column) {
+				t.Errorf("%s %s %s missing after migration %d", tt.kind, tt.name, tt.column, tt.version)
+			}
+			if version, _ := dm.SchemaVersion(); version != tt.version {
+				t.Errorf("SchemaVersion = %d, want %d", version, tt.version)
+			}
+		})
+	}
+}
+
+// TestMigrateLegacyDatabase comprueba la actualización de una base creada antes de las migraciones
+func TestMigrateLegacyDatabase(t *testing.T) {
+	dataDir := t.TempDir()
+	db, err := sql.Open("sqlite3", filepath.Join(dataDir, "tasks.db"))
+	if err != nil {
+		t.Fatalf("could not open database: %v", err)
+	}
+	// Esquema y datos de las versiones sin schema_version
+	if _, err := db.Exec(`
+		CREATE TABLE tasks (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			description TEXT NOT NULL,
+			hours REAL NOT NULL,
+			category TEXT DEFAULT 'general',
+			date TEXT NOT NULL,
+			status TEXT DEFAULT 'pending',
+			created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		);
+		INSERT INTO tasks (description, hours, category, date, status) VALUES
+			('Code review', 2.5, 'tech', '2025-07-21', 'completed'),
+			('Planned work', 0, 'tech', '2025-07-22', 'pending');
+	`); err != nil {
+		t.Fatalf("could not create legacy schema: %v", err)
+	}
+	db.Close()
+
+	dm := NewDatabaseManager(dataDir)
+	if err := dm.Init(); err != nil {
+		t.Fatalf("Init error: %v", err)
+	}
+	defer dm.Close()
+
+	if version, _ := dm.SchemaVersion(); version != len(migrations) {
+		t.Errorf("SchemaVersion = %d, want %d", version, len(migrations))
+	}
+
+	// Solo las tareas con horas reciben un registro de horas
+	var entries int
+	var hours float64
+	if err := dm.db.QueryRow(`SELECT COUNT(*), COALESCE(SUM(hours), 0) FROM time_entries`).Scan(&entries, &hours); err != nil {
+		t.Fatalf("could not count time entries: %v", err)
+	}
+	if entries != 1 || hours != 2.5 {
+		t.Errorf("time entries = %d (%.2fh), want 1 (2.50h)", entries, hours)
+	}
+
+	tasks, err := dm.LoadTasks()
+	if err != nil {
+		t.Fatalf("LoadTasks error: %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("LoadTasks returned %d tasks, want 2", len(tasks))
+	}
+
+	// Volver a migrar no cambia nada
+	if err := dm.migrate(); err != nil {
+		t.Fatalf("second migrate error: %v", err)
+	}
+	statuses, err := dm.MigrationStatus()
+	if err != nil {
+		t.Fatalf("MigrationStatus error: %v", err)
+	}
+	for _, status := range statuses {
+		if !status.Applied {
+			t.Errorf("migration %d is not applied", status.Version)
+		}
+	}
+	if err := dm.db.QueryRow(`SELECT COUNT(*) FROM time_entries`).Scan(&entries); err != nil || entries != 1 {
+		t.Errorf("time entries after a second migrate = %d, %v, want 1", entries, err)
+	}
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// AddProject crea un proyecto, creando también su cliente si todavía no existe
+func (dm *DatabaseManager) AddProject(name string, client string) (*workflow.Project, error) {
+	name = strings.TrimSpace(name)
+	client = strings.TrimSpace(client)
+	if name == "" {
+		return nil, fmt.Errorf("project name cannot be empty")
+	}
+
+	project := &workflow.Project{Name: name, Client: client}
+
+	err := dm.withTx(func(tx *sql.Tx) error {
+		var clientID interface{}
+		if client != "" {
+			id, err := ensureClient(tx, client)
+			if err != nil {
+				return err
+			}
+			clientID = id
+		}
+
+		result, err := tx.Exec(`INSERT INTO projects (name, client_id) VALUES (?, ?)`, name, clientID)
+		if err != nil {
+			if strings.Contains(err.Error(), "UNIQUE") {
+				return fmt.Errorf("project '%s' already exists", name)
+			}
+			return fmt.Errorf("could not insert project: %v", err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("could not get last insert id: %v", err)
+		}
+
+		project.ID = int(id)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// ListProjects devuelve los proyectos ordenados por cliente y nombre
+func (dm *DatabaseManager) ListProjects(includeArchived bool) ([]workflow.Project, error) {
+	query := `
+	SELECT p.id, p.name, COALESCE(c.name, ''), p.archived, p.created_at
+	FROM projects p LEFT JOIN clients c ON c.id = p.client_id`
+	if !includeArchived {
+		query += ` WHERE p.archived = 0`
+	}
+	query += ` ORDER BY COALESCE(c.name, ''), p.name`
+
+	rows, err := dm.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("could not query projects: %v", err)
+	}
+	defer rows.Close()
+
+	var projects []workflow.Project
+	for rows.Next() {
+		var project workflow.Project
+		var createdAt interface{}
+		if err := rows.Scan(&project.ID, &project.Name, &project.Client, &project.Archived, &createdAt); err != nil {
+			return nil, fmt.Errorf("could not scan project: %v", err)
+		}
+		project.CreatedAt = parseTimestamp(createdAt)
+		projects = append(projects, project)
+	}
+
+	return projects, rows.Err()
+}
+
+// ArchiveProject archiva un proyecto para que no acepte tareas nuevas
+func (dm *DatabaseManager) ArchiveProject(name string) error {
+	result, err := dm.db.Exec(`UPDATE projects SET archived = 1 WHERE name = ?`, name)
+	if err != nil {
+		return fmt.Errorf("could not archive project: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("could not get rows affected: %v", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("project '%s' not found", name)
+	}
+
+	return nil
+}
+
+// ensureClient devuelve el ID de un cliente, creándolo si no existe
+func ensureClient(tx *sql.Tx, name string) (int64, error) {
+	var id int64
+	err := tx.QueryRow(`SELECT id FROM clients WHERE name = ?`, name).Scan(&id)
+	if err == nil {
+		return id, nil
+	}
+	if err != sql.ErrNoRows {
+		return 0, fmt.Errorf("could not query client: %v", err)
+	}
+
+	result, err := tx.Exec(`INSERT INTO clients (name) VALUES (?)`, name)
+	if err != nil {
+		return 0, fmt.Errorf("could not insert client: %v", err)
+	}
+
+	return result.LastInsertId()
+}
+
+// resolveProjectID traduce un nombre de proyecto a su ID (nil si no hay proyecto)
+func resolveProjectID(tx *sql.Tx, name string, allowArchived bool) (interface{}, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	var id int64
+	var archived bool
+	err := tx.QueryRow(`SELECT id, archived FROM projects WHERE name = ?`, name).Scan(&id, &archived)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("project '%s' not found (create it with 'workflow project add')", name)
+		}
+		return nil, fmt.Errorf("could not query project: %v", err)
+	}
+
+	if archived && !allowArchived {
+		return nil, fmt.Errorf("project '%s' is archived", name)
+	}
+
+	return id, nil
+}
+
+// AddProject crea un nuevo proyecto
+func (tm *TaskManagerSQLite) AddProject(name string, client string) (*workflow.Project, error) {
+	return tm.dbManager.AddProject(name, client)
+}
+
+// ListProjects devuelve los proyectos, opcionalmente incluyendo los archivados
+func (tm *TaskManagerSQLite) ListProjects(includeArchived bool) ([]workflow.Project, error) {
+	return tm.dbManager.ListProjects(includeArchived)
+}
+
+// ArchiveProject archiva un proyecto por nombre
+func (tm *TaskManagerSQLite) ArchiveProject(name string) error {
+	return tm.dbManager.ArchiveProject(name)
+}
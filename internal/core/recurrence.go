@@ -0,0 +1,132 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Tipos de regla de recurrencia soportados
+const (
+	recurDaily             = "daily"
+	recurWeekdays          = "weekdays"
+	recurWeekly            = "weekly"
+	recurFirstBusinessDay  = "first business day of month"
+	recurLastBusinessDay   = "last business day of month"
+	recurDayOfMonth        = "day of month"
+	recurrenceRuleExamples = `"daily", "weekdays", "every monday", "every mon,thu", "first business day of month", "last business day of month", "day 15 of month"`
+)
+
+// weekdayNames mapea nombres y abreviaturas en inglés a días de la semana
+var weekdayNames = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// Recurrence es una regla de recurrencia ya interpretada
+type Recurrence struct {
+	kind     string
+	weekdays []time.Weekday
+	day      int
+}
+
+// ParseRecurrence interpreta una regla como "weekdays", "every monday" o "first business day of month"
+func ParseRecurrence(rule string) (*Recurrence, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(rule)), " ")
+
+	switch normalized {
+	case "daily", "every day":
+		return &Recurrence{kind: recurDaily}, nil
+	case "weekdays", "every weekday", "business days":
+		return &Recurrence{kind: recurWeekdays}, nil
+	case recurFirstBusinessDay, "first business day of the month":
+		return &Recurrence{kind: recurFirstBusinessDay}, nil
+	case recurLastBusinessDay, "last business day of the month":
+		return &Recurrence{kind: recurLastBusinessDay}, nil
+	}
+
+	if strings.HasPrefix(normalized, "every ") {
+		names := strings.Split(strings.TrimPrefix(normalized, "every "), ",")
+		recurrence := &Recurrence{kind: recurWeekly}
+		for _, name := range names {
+			weekday, exists := weekdayNames[strings.TrimSpace(name)]
+			if !exists {
+				return nil, fmt.Errorf("invalid weekday '%s' in rule '%s'", strings.TrimSpace(name), rule)
+			}
+			recurrence.weekdays = append(recurrence.weekdays, weekday)
+		}
+		return recurrence, nil
+	}
+
+	var day int
+	if _, err := fmt.Sscanf(normalized, "day %d of month", &day); err == nil {
+		if day < 1 || day > 31 {
+			return nil, fmt.Errorf("invalid day of month %d in rule '%s'", day, rule)
+		}
+		return &Recurrence{kind: recurDayOfMonth, day: day}, nil
+	}
+
+	return nil, fmt.Errorf("invalid recurrence rule '%s' (examples: %s)", rule, recurrenceRuleExamples)
+}
+
+// String devuelve la regla en su forma normalizada
+func (r *Recurrence) String() string {
+	switch r.kind {
+	case recurWeekly:
+		names := make([]string, len(r.weekdays))
+		for i, weekday := range r.weekdays {
+			names[i] = strings.ToLower(weekday.String())
+		}
+		return "every " + strings.Join(names, ",")
+	case recurDayOfMonth:
+		return "day " + strconv.Itoa(r.day) + " of month"
+	default:
+		return r.kind
+	}
+}
+
+// Matches indica si la regla genera una tarea en la fecha indicada.
+// Los días hábiles son los laborables del calendario que no son feriados.
+func (r *Recurrence) Matches(date time.Time, calendar *Calendar) bool {
+	switch r.kind {
+	case recurDaily:
+		return true
+	case recurWeekdays:
+		return calendar.IsBusinessDay(date)
+	case recurWeekly:
+		for _, weekday := range r.weekdays {
+			if date.Weekday() == weekday {
+				return true
+			}
+		}
+		return false
+	case recurFirstBusinessDay:
+		first := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+		for !calendar.IsBusinessDay(first) {
+			first = first.AddDate(0, 0, 1)
+		}
+		return sameDay(date, first)
+	case recurLastBusinessDay:
+		last := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, date.Location())
+		for !calendar.IsBusinessDay(last) {
+			last = last.AddDate(0, 0, -1)
+		}
+		return sameDay(date, last)
+	case recurDayOfMonth:
+		// En meses más cortos se usa el último día del mes
+		lastDay := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, date.Location()).Day()
+		return date.Day() == min(r.day, lastDay)
+	}
+	return false
+}
+
+// sameDay indica si dos fechas corresponden al mismo día
+func sameDay(a time.Time, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
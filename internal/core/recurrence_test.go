@@ -0,0 +1,90 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// TestParseRecurrence comprueba la forma normalizada de cada regla y las fechas que genera
+func TestParseRecurrence(t *testing.T) {
+	calendar := newTestCalendar(t)
+
+	tests := []struct {
+		rule     string
+		want     string
+		matches  []string
+		excludes []string
+	}{
+		{rule: "daily", want: "daily", matches: []string{"2025-08-02", "2025-08-04"}},
+		{rule: "Every Day", want: "daily", matches: []string{"2025-08-03"}},
+		{rule: "weekdays", want: "weekdays", matches: []string{"2025-08-01", "2025-08-04"}, excludes: []string{"2025-08-02", "2025-08-03", "2025-08-18"}},
+		{rule: "business days", want: "weekdays", matches: []string{"2025-08-05"}, excludes: []string{"2025-08-09"}},
+		{rule: "every monday", want: "every monday", matches: []string{"2025-08-04"}, excludes: []string{"2025-08-05"}},
+		{rule: "every mon, thu", want: "every monday,thursday", matches: []string{"2025-08-04", "2025-08-07"}, excludes: []string{"2025-08-06"}},
+		{rule: "first business day of month", want: "first business day of month", matches: []string{"2025-08-01", "2025-11-03", "2025-09-02"}, excludes: []string{"2025-11-01", "2025-08-04", "2025-09-01"}},
+		{rule: "last business day of the month", want: "last business day of month", matches: []string{"2025-08-29", "2025-07-31", "2025-10-30"}, excludes: []string{"2025-08-31", "2025-08-28", "2025-10-31"}},
+		{rule: "day 15 of month", want: "day 15 of month", matches: []string{"2025-08-15"}, excludes: []string{"2025-08-14"}},
+		{rule: "day 31 of month", want: "day 31 of month", matches: []string{"2025-02-28", "2024-02-29", "2025-08-31"}, excludes: []string{"2024-02-28", "2025-09-01"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			recurrence, err := ParseRecurrence(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRecurrence(%q) error: %v", tt.rule, err)
+			}
+			if got := recurrence.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			for _, date := range tt.matches {
+				if !recurrence.Matches(mustParseDate(t, date), calendar) {
+					t.Errorf("Matches(%s) = false, want true", date)
+				}
+			}
+			for _, date := range tt.excludes {
+				if recurrence.Matches(mustParseDate(t, date), calendar) {
+					t.Errorf("Matches(%s) = true, want false", date)
+				}
+			}
+		})
+	}
+}
+
+// TestParseRecurrenceInvalid comprueba que las reglas mal escritas se rechacen
+func TestParseRecurrenceInvalid(t *testing.T) {
+	rules := []string{"", "sometimes", "every funday", "every mon,", "day 0 of month", "day 32 of month"}
+
+	for _, rule := range rules {
+		if _, err := ParseRecurrence(rule); err == nil {
+			t.Errorf("ParseRecurrence(%q) should fail", rule)
+		}
+	}
+}
+
+// newTestCalendar crea una semana de lunes a viernes con algunos feriados
+func newTestCalendar(t *testing.T) *Calendar {
+	t.Helper()
+	config := workflow.DefaultWorkCalendar()
+	config.Holidays = []workflow.Holiday{
+		{Date: "2025-08-18", Name: "Paso a la Inmortalidad de San Martín"},
+		{Date: "2025-09-01", Name: "Company day"},
+		{Date: "2025-10-31", Name: "Company day"},
+	}
+	calendar, err := NewCalendar(config, 8)
+	if err != nil {
+		t.Fatalf("NewCalendar error: %v", err)
+	}
+	return calendar
+}
+
+// mustParseDate interpreta una fecha YYYY-MM-DD o termina el test
+func mustParseDate(t *testing.T, value string) time.Time {
+	t.Helper()
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		t.Fatalf("invalid test date %s: %v", value, err)
+	}
+	return date
+}
@@ -0,0 +1,213 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// AddRecurringTemplate guarda una plantilla de tarea recurrente
+func (dm *DatabaseManager) AddRecurringTemplate(template *workflow.RecurringTemplate) error {
+	result, err := dm.db.Exec(`
+	INSERT INTO recurring_templates (description, hours, category, rule, start_date)
+	VALUES (?, ?, ?, ?, ?)
+	`, template.Description, template.Hours, template.Category, template.Rule, template.StartDate)
+	if err != nil {
+		return fmt.Errorf("could not insert recurring template: %v", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("could not get last insert id: %v", err)
+	}
+
+	template.ID = int(id)
+	return nil
+}
+
+// ListRecurringTemplates devuelve todas las plantillas recurrentes
+func (dm *DatabaseManager) ListRecurringTemplates() ([]workflow.RecurringTemplate, error) {
+	rows, err := dm.db.Query(`
+	SELECT id, description, hours, category, rule, start_date, created_at
+	FROM recurring_templates ORDER BY id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("could not query recurring templates: %v", err)
+	}
+	defer rows.Close()
+
+	var templates []workflow.RecurringTemplate
+	for rows.Next() {
+		var template workflow.RecurringTemplate
+		var createdAt interface{}
+		if err := rows.Scan(&template.ID, &template.Description, &template.Hours, &template.Category,
+			&template.Rule, &template.StartDate, &createdAt); err != nil {
+			return nil, fmt.Errorf("could not scan recurring template: %v", err)
+		}
+		template.CreatedAt = parseTimestamp(createdAt)
+		templates = append(templates, template)
+	}
+
+	return templates, rows.Err()
+}
+
+// RemoveRecurringTemplate elimina una plantilla; las tareas ya creadas se conservan
+func (dm *DatabaseManager) RemoveRecurringTemplate(id int) error {
+	return dm.withTx(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(`DELETE FROM recurring_instances WHERE template_id = ?`, id); err != nil {
+			return fmt.Errorf("could not delete recurring instances: %v", err)
+		}
+
+		result, err := tx.Exec(`DELETE FROM recurring_templates WHERE id = ?`, id)
+		if err != nil {
+			return fmt.Errorf("could not delete recurring template: %v", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("could not get rows affected: %v", err)
+		}
+
+		if rowsAffected == 0 {
+			return fmt.Errorf("recurring template with ID %d not found", id)
+		}
+
+		return nil
+	})
+}
+
+// ApplyRecurringTemplates crea las tareas pendientes de todas las plantillas hasta la fecha indicada.
+// Cada fecha generada queda registrada en recurring_instances, por lo que volver a
+// ejecutarlo no crea duplicados aunque la tarea se haya borrado después.
+// Los días hábiles de las reglas se toman del calendario laboral.
+func (dm *DatabaseManager) ApplyRecurringTemplates(until string, calendar *Calendar) ([]workflow.Task, error) {
+	untilDate, err := time.Parse("2006-01-02", until)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date format: %s (use YYYY-MM-DD)", until)
+	}
+
+	templates, err := dm.ListRecurringTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	var created []workflow.Task
+	err = dm.withTx(func(tx *sql.Tx) error {
+		for _, template := range templates {
+			recurrence, err := ParseRecurrence(template.Rule)
+			if err != nil {
+				return fmt.Errorf("template %d: %v", template.ID, err)
+			}
+
+			startDate, err := time.Parse("2006-01-02", template.StartDate)
+			if err != nil {
+				return fmt.Errorf("template %d: invalid start date %s", template.ID, template.StartDate)
+			}
+
+			for date := startDate; !date.After(untilDate); date = date.AddDate(0, 0, 1) {
+				if !recurrence.Matches(date, calendar) {
+					continue
+				}
+
+				task, err := applyRecurringInstance(tx, template, date.Format("2006-01-02"))
+				if err != nil {
+					return err
+				}
+				if task != nil {
+					created = append(created, *task)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// applyRecurringInstance crea la tarea de una plantilla para una fecha si todavía no existe
+func applyRecurringInstance(tx *sql.Tx, template workflow.RecurringTemplate, date string) (*workflow.Task, error) {
+	result, err := tx.Exec(`INSERT OR IGNORE INTO recurring_instances (template_id, date) VALUES (?, ?)`, template.ID, date)
+	if err != nil {
+		return nil, fmt.Errorf("could not record recurring instance: %v", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("could not get rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		// Ya generada en una ejecución anterior
+		return nil, nil
+	}
+
+	task := &workflow.Task{
+		Description: template.Description,
+		Hours:       template.Hours,
+		Category:    template.Category,
+		Date:        date,
+		Status:      workflow.StatusPending,
+		CreatedAt:   time.Now().UTC(),
+	}
+	if err := insertTask(tx, task); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(`UPDATE recurring_instances SET task_id = ? WHERE template_id = ? AND date = ?`, task.ID, template.ID, date); err != nil {
+		return nil, fmt.Errorf("could not link recurring instance: %v", err)
+	}
+
+	return task, nil
+}
+
+// AddRecurringTemplate valida y guarda una plantilla recurrente
+func (tm *TaskManagerSQLite) AddRecurringTemplate(template *workflow.RecurringTemplate) error {
+	if strings.TrimSpace(template.Description) == "" {
+		return fmt.Errorf("description cannot be empty")
+	}
+	if template.Hours <= 0 {
+		return fmt.Errorf("hours must be greater than zero")
+	}
+	if err := tm.configManager.ValidateCategory(template.Category); err != nil {
+		return err
+	}
+
+	recurrence, err := ParseRecurrence(template.Rule)
+	if err != nil {
+		return err
+	}
+	template.Rule = recurrence.String()
+
+	if template.StartDate == "" {
+		template.StartDate = Today()
+	}
+	if _, err := time.Parse("2006-01-02", template.StartDate); err != nil {
+		return fmt.Errorf("invalid date format: %s (use YYYY-MM-DD)", template.StartDate)
+	}
+
+	return tm.dbManager.AddRecurringTemplate(template)
+}
+
+// ListRecurringTemplates devuelve las plantillas recurrentes
+func (tm *TaskManagerSQLite) ListRecurringTemplates() ([]workflow.RecurringTemplate, error) {
+	return tm.dbManager.ListRecurringTemplates()
+}
+
+// RemoveRecurringTemplate elimina una plantilla recurrente por ID
+func (tm *TaskManagerSQLite) RemoveRecurringTemplate(id int) error {
+	return tm.dbManager.RemoveRecurringTemplate(id)
+}
+
+// ApplyRecurringTemplates crea las tareas recurrentes pendientes hasta la fecha indicada
+func (tm *TaskManagerSQLite) ApplyRecurringTemplates(until string) ([]workflow.Task, error) {
+	calendar, err := tm.configManager.GetCalendar()
+	if err != nil {
+		return nil, err
+	}
+	return tm.dbManager.ApplyRecurringTemplates(until, calendar)
+}
@@ -0,0 +1,167 @@
+package core
+
+import (
+	"sort"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// Report es el contenido de un reporte de horas, independiente de cómo se presenta.
+// Kind es el tipo de período (date, week, month o range); From o To vacíos no limitan.
+type Report struct {
+	Kind           string
+	From           string
+	To             string
+	Tasks          []workflow.Task
+	Days           []ReportDay
+	TotalHours     float64
+	CompletedHours float64
+	Categories     []ReportGroup
+	Statuses       []ReportGroup
+	Projects       []ReportGroup // vacío si ninguna tarea tiene proyecto
+	Expected       *ReportExpected
+}
+
+// ReportDay agrupa las tareas trabajadas en un día
+type ReportDay struct {
+	Date  string
+	Tasks []workflow.Task
+	Hours float64
+}
+
+// ReportGroup son las horas y tareas de una categoría, estado o proyecto
+type ReportGroup struct {
+	Name  string
+	Hours float64
+	Tasks int
+
+	taskIDs map[int]bool // una tarea trabajada en varios días cuenta una vez
+}
+
+// ReportExpected compara las horas trabajadas con las esperadas por el calendario laboral
+type ReportExpected struct {
+	Scheduled    float64 // horas del calendario sin descontar ausencias
+	Expected     float64 // horas esperadas descontando ausencias
+	Worked       float64
+	BalanceStart string  // vacío si el saldo acumulado no aplica al período
+	Balance      float64 // saldo acumulado desde BalanceStart hasta el final del período
+}
+
+// Leave devuelve las horas de ausencia del período
+func (e ReportExpected) Leave() float64 {
+	return e.Scheduled - e.Expected
+}
+
+// Difference devuelve las horas trabajadas menos las esperadas
+func (e ReportExpected) Difference() float64 {
+	return e.Worked - e.Expected
+}
+
+// NewReport calcula los totales de un reporte a partir de las tareas del período
+func NewReport(kind string, from string, to string, tasks []workflow.Task) *Report {
+	report := &Report{Kind: kind, From: from, To: to, Tasks: tasks}
+
+	categories := make(map[string]*ReportGroup)
+	statuses := make(map[string]*ReportGroup)
+	projects := make(map[string]*ReportGroup)
+	days := make(map[string]*ReportDay)
+	hasProjects := false
+
+	for _, task := range tasks {
+		report.TotalHours += task.Hours
+		if task.Status == workflow.StatusCompleted {
+			report.CompletedHours += task.Hours
+		}
+
+		addToGroup(categories, task.Category, task)
+		addToGroup(statuses, task.Status, task)
+
+		if task.Project != "" {
+			hasProjects = true
+		}
+		addToGroup(projects, projectGroupName(task), task)
+
+		day, exists := days[task.Date]
+		if !exists {
+			day = &ReportDay{Date: task.Date}
+			days[task.Date] = day
+		}
+		day.Tasks = append(day.Tasks, task)
+		day.Hours += task.Hours
+	}
+
+	report.Categories = sortedGroups(categories)
+	report.Statuses = sortedGroups(statuses)
+	if hasProjects {
+		report.Projects = sortedGroups(projects)
+	}
+
+	for _, day := range days {
+		report.Days = append(report.Days, *day)
+	}
+	sort.Slice(report.Days, func(i, j int) bool {
+		return report.Days[i].Date < report.Days[j].Date
+	})
+
+	return report
+}
+
+// TaskCount devuelve la cantidad de tareas distintas del reporte.
+// Una tarea trabajada en varios días aparece una vez por día en Tasks.
+func (r *Report) TaskCount() int {
+	ids := make(map[int]bool, len(r.Tasks))
+	for _, task := range r.Tasks {
+		ids[task.ID] = true
+	}
+	return len(ids)
+}
+
+// PendingHours devuelve las horas de tareas no completadas
+func (r *Report) PendingHours() float64 {
+	return r.TotalHours - r.CompletedHours
+}
+
+// CompletionRate devuelve el porcentaje de horas completadas
+func (r *Report) CompletionRate() float64 {
+	if r.TotalHours == 0 {
+		return 0
+	}
+	return r.CompletedHours / r.TotalHours * 100
+}
+
+// projectGroupName devuelve el nombre con el que se agrupan las horas del proyecto de una tarea
+func projectGroupName(task workflow.Task) string {
+	if task.Project == "" {
+		return "(no project)"
+	}
+	if task.Client != "" {
+		return task.Project + " (" + task.Client + ")"
+	}
+	return task.Project
+}
+
+// addToGroup suma las horas de una tarea al grupo con el nombre indicado y la cuenta una sola vez
+func addToGroup(groups map[string]*ReportGroup, name string, task workflow.Task) {
+	group, exists := groups[name]
+	if !exists {
+		group = &ReportGroup{Name: name, taskIDs: make(map[int]bool)}
+		groups[name] = group
+	}
+	group.Hours += task.Hours
+	if !group.taskIDs[task.ID] {
+		group.taskIDs[task.ID] = true
+		group.Tasks++
+	}
+}
+
+// sortedGroups devuelve los grupos ordenados por nombre
+func sortedGroups(groups map[string]*ReportGroup) []ReportGroup {
+	result := make([]ReportGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
@@ -0,0 +1,175 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// Backends de almacenamiento soportados
+const (
+	BackendSQLite = "sqlite"
+	BackendJSON   = "json"
+)
+
+// TaskStore define las operaciones comunes a todos los backends de tareas
+type TaskStore interface {
+	LoadTasks() ([]workflow.Task, error)
+	SaveTasks(tasks []workflow.Task) error
+	AddTask(description string, hours float64, category string, date string) error
+	CreateTask(task *workflow.Task) error
+	UpdateTask(id int, description string, hours float64, category string) error
+	ReplaceTask(task *workflow.Task) error
+	DeleteTask(id int) error
+	GetTaskByID(id int) (*workflow.Task, error)
+	GetTasksByIDs(ids []int) ([]workflow.Task, error)
+	GetTodayTasks() ([]workflow.Task, error)
+	GetTasksByDate(date string) ([]workflow.Task, error)
+	SearchTasks(query string, category string, status string, date string) ([]workflow.Task, error)
+	SearchTasksInRange(filter TaskFilter) ([]workflow.Task, error)
+	CompleteTask(id int) error
+	UpdateTaskStatus(id int, status string) error
+	GetTotalHours(tasks []workflow.Task) float64
+	GetDailyHoursTarget() float64
+	GetDailyStandupHours() float64
+	ValidateCategory(name string) error
+	CountTasksInCategory(category string) (int, error)
+	RenameCategory(oldName string, newName string) (int, error)
+	ListTrash() ([]workflow.Task, error)
+	RestoreTask(id int) error
+	EmptyTrash(before time.Time) (int, error)
+	ApplyBatch(batch TaskBatch) error
+	ImportTasks(tasks []ExportedTask) error
+	Close() error
+}
+
+// TaskBatch agrupa cambios sobre varias tareas que se aplican todos o ninguno
+type TaskBatch struct {
+	Create []*workflow.Task // tareas nuevas; reciben su ID al aplicarse
+	Update []*workflow.Task // tareas existentes con todos sus campos nuevos
+	Delete []int            // IDs de tareas a enviar a la papelera
+}
+
+// TaskFilter define los criterios de búsqueda por rango de fechas.
+// From y To son inclusivos en formato YYYY-MM-DD; un valor vacío no limita.
+type TaskFilter struct {
+	From     string   `json:"from,omitempty"`
+	To       string   `json:"to,omitempty"`
+	Category string   `json:"category,omitempty"`
+	Status   string   `json:"status,omitempty"`
+	Query    string   `json:"query,omitempty"`
+	Project  string   `json:"project,omitempty"`
+	Client   string   `json:"client,omitempty"`
+	Tags     []string `json:"tags,omitempty"`     // la tarea debe tener todas estas etiquetas
+	AnyTags  []string `json:"any_tags,omitempty"` // la tarea debe tener al menos una de estas etiquetas
+}
+
+// Matches indica si una tarea cumple todos los criterios del filtro
+func (f TaskFilter) Matches(task workflow.Task) bool {
+	if task.InTrash() {
+		return false
+	}
+	if f.From != "" && task.Date < f.From {
+		return false
+	}
+	if f.To != "" && task.Date > f.To {
+		return false
+	}
+	if f.Category != "" && task.Category != f.Category {
+		return false
+	}
+	if f.Status != "" && task.Status != f.Status {
+		return false
+	}
+	if f.Query != "" && !strings.Contains(strings.ToLower(task.Description), strings.ToLower(f.Query)) {
+		return false
+	}
+	if f.Project != "" && task.Project != f.Project {
+		return false
+	}
+	if f.Client != "" && task.Client != f.Client {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !hasTag(task, tag) {
+			return false
+		}
+	}
+	if len(f.AnyTags) > 0 {
+		matched := false
+		for _, tag := range f.AnyTags {
+			if hasTag(task, tag) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// Verificación en tiempo de compilación de que ambos backends implementan TaskStore
+var (
+	_ TaskStore = (*TaskManager)(nil)
+	_ TaskStore = (*TaskManagerSQLite)(nil)
+)
+
+// NewTaskStore crea el almacenamiento de tareas según el backend configurado
+func NewTaskStore() TaskStore {
+	configManager := LoadConfigManager()
+
+	switch configManager.Get().Backend {
+	case BackendJSON:
+		return newTaskManager(configManager)
+	default:
+		return newTaskManagerSQLite(configManager)
+	}
+}
+
+// NewSQLiteTaskStore crea el almacenamiento SQLite para los comandos que solo existen en ese backend.
+// Falla si la configuración usa el backend JSON, para no repartir los datos entre los dos.
+func NewSQLiteTaskStore() (*TaskManagerSQLite, error) {
+	configManager := LoadConfigManager()
+	if configManager.Get().Backend == BackendJSON {
+		return nil, fmt.Errorf("this command needs the SQLite backend (set \"backend\": \"sqlite\" in config.json and run 'workflow migrate')")
+	}
+	return newTaskManagerSQLite(configManager), nil
+}
+
+// LoadConfigManager carga la configuración avisando si no se puede leer
+func LoadConfigManager() *ConfigManager {
+	configManager := NewConfigManager()
+	if err := configManager.Load(); err != nil {
+		// Si no puede cargar configuración, usar valores por defecto
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: Could not load config: %v\n", err)
+	}
+	return configManager
+}
+
+// ValidateStatus devuelve un error si el estado no es uno de los estados de tarea conocidos
+func ValidateStatus(status string) error {
+	if !isValidStatus(status) {
+		return fmt.Errorf("invalid status: %s. Valid statuses are: %v", status, validStatuses())
+	}
+	return nil
+}
+
+// isValidStatus indica si un estado es uno de los estados de tarea conocidos
+func isValidStatus(status string) bool {
+	for _, validStatus := range validStatuses() {
+		if status == validStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// validStatuses devuelve la lista de estados de tarea válidos
+func validStatuses() []string {
+	return []string{workflow.StatusPending, workflow.StatusInProgress, workflow.StatusCompleted, workflow.StatusPaused}
+}
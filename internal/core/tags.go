@@ -0,0 +1,77 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// taskTagsColumn devuelve las etiquetas de la tarea t separadas por comas
+const taskTagsColumn = `COALESCE((SELECT GROUP_CONCAT(tag, ',') FROM task_tags WHERE task_id = t.id), '')`
+
+// NormalizeTags limpia, valida y deduplica una lista de etiquetas.
+// Se acepta el prefijo '#' por comodidad y se descarta al guardar.
+func NormalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var normalized []string
+
+	for _, tag := range tags {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+		if tag == "" {
+			continue
+		}
+		if strings.ContainsAny(tag, ", ") {
+			return nil, fmt.Errorf("invalid tag '%s': tags cannot contain spaces or commas", tag)
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+
+	sort.Strings(normalized)
+	return normalized, nil
+}
+
+// splitTags convierte la lista de etiquetas concatenadas en un slice ordenado
+func splitTags(tags string) []string {
+	if tags == "" {
+		return nil
+	}
+	parts := strings.Split(tags, ",")
+	sort.Strings(parts)
+	return parts
+}
+
+// hasTag indica si una tarea tiene una etiqueta
+func hasTag(task workflow.Task, tag string) bool {
+	for _, taskTag := range task.Tags {
+		if taskTag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// replaceTaskTags reemplaza las etiquetas de una tarea por las indicadas
+func replaceTaskTags(tx *sql.Tx, taskID int, tags []string) error {
+	if _, err := tx.Exec(`DELETE FROM task_tags WHERE task_id = ?`, taskID); err != nil {
+		return fmt.Errorf("could not clear task tags: %v", err)
+	}
+
+	for _, tag := range tags {
+		if _, err := tx.Exec(`INSERT OR IGNORE INTO task_tags (task_id, tag) VALUES (?, ?)`, taskID, tag); err != nil {
+			return fmt.Errorf("could not insert task tag: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// placeholders genera una lista de n parámetros '?' separados por comas
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
@@ -20,12 +20,11 @@ type TaskManager struct {
 
 // NewTaskManager crea un nuevo gestor de tareas
 func NewTaskManager() *TaskManager {
-	configManager := NewConfigManager()
-	if err := configManager.Load(); err != nil {
-		// Si no puede cargar configuración, usar valores por defecto
-		fmt.Printf("⚠️  Warning: Could not load config: %v\n", err)
-	}
+	return newTaskManager(LoadConfigManager())
+}
 
+// newTaskManager crea un gestor de tareas JSON con una configuración ya cargada
+func newTaskManager(configManager *ConfigManager) *TaskManager {
 	return &TaskManager{
 		configManager: configManager,
 		dataFile:      configManager.GetDataFile(),
@@ -95,6 +94,30 @@ func (tm *TaskManager) LoadTasks() ([]workflow.Task, error) {
 			task.Date = date
 		}
 
+		// Project
+		if project, ok := rawTask["project"].(string); ok {
+			task.Project = project
+		}
+
+		// Tags
+		if rawTags, ok := rawTask["tags"].([]interface{}); ok {
+			for _, rawTag := range rawTags {
+				if tag, ok := rawTag.(string); ok {
+					task.Tags = append(task.Tags, tag)
+				}
+			}
+		}
+
+		// Billable (solo presente cuando difiere de la categoría)
+		if billable, ok := rawTask["billable"].(bool); ok {
+			task.Billable = &billable
+		}
+
+		// DeletedAt (solo presente en tareas enviadas a la papelera)
+		if deletedAt, ok := rawTask["deleted_at"].(string); ok {
+			task.DeletedAt, _ = time.Parse(time.RFC3339Nano, deletedAt)
+		}
+
 		// Status (con valor por defecto para tareas existentes)
 		if status, ok := rawTask["status"].(string); ok {
 			task.Status = status
@@ -102,7 +125,8 @@ func (tm *TaskManager) LoadTasks() ([]workflow.Task, error) {
 			task.Status = workflow.StatusPending // Valor por defecto para tareas existentes
 		}
 
-		// CreatedAt - manejar diferentes formatos
+		// CreatedAt - manejar diferentes formatos; los que no tienen zona se
+		// escribieron con el reloj local, así que se leen en la zona configurada
 		if createdAt, ok := rawTask["created_at"].(string); ok {
 			// Intentar diferentes formatos de fecha
 			formats := []string{
@@ -116,19 +140,19 @@ func (tm *TaskManager) LoadTasks() ([]workflow.Task, error) {
 			var parseErr error
 
 			for _, format := range formats {
-				if parsedTime, parseErr = time.Parse(format, createdAt); parseErr == nil {
+				if parsedTime, parseErr = time.ParseInLocation(format, createdAt, Location()); parseErr == nil {
 					break
 				}
 			}
 
 			if parseErr != nil {
 				// Si no se puede parsear, usar tiempo actual
-				task.CreatedAt = time.Now()
+				task.CreatedAt = time.Now().UTC()
 			} else {
-				task.CreatedAt = parsedTime
+				task.CreatedAt = parsedTime.UTC()
 			}
 		} else {
-			task.CreatedAt = time.Now()
+			task.CreatedAt = time.Now().UTC()
 		}
 
 		tasks = append(tasks, task)
@@ -168,21 +192,12 @@ func (tm *TaskManager) AddTask(description string, hours float64, category strin
 	}
 
 	// Generar nuevo ID
-	newID := 1
-	if len(tasks) > 0 {
-		maxID := 0
-		for _, task := range tasks {
-			if task.ID > maxID {
-				maxID = task.ID
-			}
-		}
-		newID = maxID + 1
-	}
+	newID := nextTaskID(tasks)
 
 	// Usar fecha proporcionada o fecha actual
 	taskDate := date
 	if taskDate == "" {
-		taskDate = time.Now().Format("2006-01-02")
+		taskDate = Today()
 	}
 
 	// Crear nueva tarea
@@ -193,7 +208,7 @@ func (tm *TaskManager) AddTask(description string, hours float64, category strin
 		Category:    category,
 		Date:        taskDate,
 		Status:      workflow.StatusPending,
-		CreatedAt:   time.Now(),
+		CreatedAt:   time.Now().UTC(),
 	}
 
 	// Agregar a la lista
@@ -207,6 +222,23 @@ func (tm *TaskManager) AddTask(description string, hours float64, category strin
 	return nil
 }
 
+// CreateTask guarda una tarea ya construida asignándole un nuevo ID
+func (tm *TaskManager) CreateTask(task *workflow.Task) error {
+	tasks, err := tm.LoadTasks()
+	if err != nil {
+		return fmt.Errorf("could not load tasks: %v", err)
+	}
+
+	task.ID = nextTaskID(tasks)
+	tasks = append(tasks, *task)
+
+	if err := tm.SaveTasks(tasks); err != nil {
+		return fmt.Errorf("could not save tasks: %v", err)
+	}
+
+	return nil
+}
+
 // UpdateTask actualiza una tarea existente por ID
 func (tm *TaskManager) UpdateTask(id int, description string, hours float64, category string) error {
 	tasks, err := tm.LoadTasks()
@@ -217,7 +249,7 @@ func (tm *TaskManager) UpdateTask(id int, description string, hours float64, cat
 	// Buscar la tarea por ID
 	taskIndex := -1
 	for i, task := range tasks {
-		if task.ID == id {
+		if task.ID == id && !task.InTrash() {
 			taskIndex = i
 			break
 		}
@@ -246,7 +278,28 @@ func (tm *TaskManager) UpdateTask(id int, description string, hours float64, cat
 	return nil
 }
 
-// DeleteTask elimina una tarea por ID
+// ReplaceTask guarda todos los campos de una tarea existente
+func (tm *TaskManager) ReplaceTask(task *workflow.Task) error {
+	tasks, err := tm.LoadTasks()
+	if err != nil {
+		return fmt.Errorf("could not load tasks: %v", err)
+	}
+
+	for i := range tasks {
+		if tasks[i].ID == task.ID && !tasks[i].InTrash() {
+			tasks[i] = *task
+
+			if err := tm.SaveTasks(tasks); err != nil {
+				return fmt.Errorf("could not save tasks: %v", err)
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("task with ID %d not found", task.ID)
+}
+
+// DeleteTask envía una tarea a la papelera por ID
 func (tm *TaskManager) DeleteTask(id int) error {
 	tasks, err := tm.LoadTasks()
 	if err != nil {
@@ -256,7 +309,7 @@ func (tm *TaskManager) DeleteTask(id int) error {
 	// Buscar y eliminar la tarea por ID
 	taskIndex := -1
 	for i, task := range tasks {
-		if task.ID == id {
+		if task.ID == id && !task.InTrash() {
 			taskIndex = i
 			break
 		}
@@ -266,8 +319,8 @@ func (tm *TaskManager) DeleteTask(id int) error {
 		return fmt.Errorf("task with ID %d not found", id)
 	}
 
-	// Eliminar la tarea
-	tasks = append(tasks[:taskIndex], tasks[taskIndex+1:]...)
+	// Enviar la tarea a la papelera
+	tasks[taskIndex].DeletedAt = time.Now().UTC()
 
 	// Guardar cambios
 	if err := tm.SaveTasks(tasks); err != nil {
@@ -285,7 +338,7 @@ func (tm *TaskManager) GetTaskByID(id int) (*workflow.Task, error) {
 	}
 
 	for _, task := range tasks {
-		if task.ID == id {
+		if task.ID == id && !task.InTrash() {
 			return &task, nil
 		}
 	}
@@ -293,6 +346,30 @@ func (tm *TaskManager) GetTaskByID(id int) (*workflow.Task, error) {
 	return nil, fmt.Errorf("task with ID %d not found", id)
 }
 
+// GetTasksByIDs obtiene las tareas con los IDs indicados, ordenadas por ID.
+// Los IDs que no existen o están en la papelera se omiten.
+func (tm *TaskManager) GetTasksByIDs(ids []int) ([]workflow.Task, error) {
+	tasks, err := tm.LoadTasks()
+	if err != nil {
+		return nil, fmt.Errorf("could not load tasks: %v", err)
+	}
+
+	wanted := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	var found []workflow.Task
+	for _, task := range tasks {
+		if wanted[task.ID] && !task.InTrash() {
+			found = append(found, task)
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool { return found[i].ID < found[j].ID })
+	return found, nil
+}
+
 // GetTodayTasks obtiene las tareas del día actual
 func (tm *TaskManager) GetTodayTasks() ([]workflow.Task, error) {
 	tasks, err := tm.LoadTasks()
@@ -300,11 +377,11 @@ func (tm *TaskManager) GetTodayTasks() ([]workflow.Task, error) {
 		return nil, err
 	}
 
-	today := time.Now().Format("2006-01-02")
+	today := Today()
 	var todayTasks []workflow.Task
 
 	for _, task := range tasks {
-		if task.Date == today {
+		if task.Date == today && !task.InTrash() {
 			todayTasks = append(todayTasks, task)
 		}
 	}
@@ -340,7 +417,7 @@ func (tm *TaskManager) GetTasksByDate(date string) ([]workflow.Task, error) {
 
 	var filteredTasks []workflow.Task
 	for _, task := range tasks {
-		if task.Date == date {
+		if task.Date == date && !task.InTrash() {
 			filteredTasks = append(filteredTasks, task)
 		}
 	}
@@ -357,7 +434,7 @@ func (tm *TaskManager) CompleteTask(id int) error {
 	// Buscar la tarea por ID
 	taskIndex := -1
 	for i, task := range tasks {
-		if task.ID == id {
+		if task.ID == id && !task.InTrash() {
 			taskIndex = i
 			break
 		}
@@ -388,7 +465,7 @@ func (tm *TaskManager) UpdateTaskStatus(id int, status string) error {
 	// Buscar la tarea por ID
 	taskIndex := -1
 	for i, task := range tasks {
-		if task.ID == id {
+		if task.ID == id && !task.InTrash() {
 			taskIndex = i
 			break
 		}
@@ -399,17 +476,8 @@ func (tm *TaskManager) UpdateTaskStatus(id int, status string) error {
 	}
 
 	// Validar estado
-	validStatuses := []string{workflow.StatusPending, workflow.StatusInProgress, workflow.StatusCompleted, workflow.StatusPaused}
-	isValid := false
-	for _, validStatus := range validStatuses {
-		if status == validStatus {
-			isValid = true
-			break
-		}
-	}
-
-	if !isValid {
-		return fmt.Errorf("invalid status: %s. Valid statuses are: %v", status, validStatuses)
+	if !isValidStatus(status) {
+		return fmt.Errorf("invalid status: %s. Valid statuses are: %v", status, validStatuses())
 	}
 
 	// Actualizar estado
@@ -433,6 +501,11 @@ func (tm *TaskManager) SearchTasks(query string, category string, status string,
 	var filteredTasks []workflow.Task
 
 	for _, task := range tasks {
+		// Las tareas de la papelera no aparecen en búsquedas
+		if task.InTrash() {
+			continue
+		}
+
 		// Filtro por texto (descripción)
 		if query != "" {
 			if !strings.Contains(strings.ToLower(task.Description), strings.ToLower(query)) {
@@ -474,3 +547,44 @@ func (tm *TaskManager) SearchTasks(query string, category string, status string,
 
 	return filteredTasks, nil
 }
+
+// Close libera los recursos del gestor (no-op para el backend JSON)
+func (tm *TaskManager) Close() error {
+	return nil
+}
+
+// nextTaskID calcula el siguiente ID disponible para una lista de tareas
+func nextTaskID(tasks []workflow.Task) int {
+	maxID := 0
+	for _, task := range tasks {
+		if task.ID > maxID {
+			maxID = task.ID
+		}
+	}
+	return maxID + 1
+}
+
+// SearchTasksInRange busca tareas entre dos fechas (inclusive) aplicando los filtros indicados
+func (tm *TaskManager) SearchTasksInRange(filter TaskFilter) ([]workflow.Task, error) {
+	tasks, err := tm.LoadTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	var filteredTasks []workflow.Task
+	for _, task := range tasks {
+		if filter.Matches(task) {
+			filteredTasks = append(filteredTasks, task)
+		}
+	}
+
+	// Ordenar por fecha ascendente y luego por ID
+	sort.Slice(filteredTasks, func(i, j int) bool {
+		if filteredTasks[i].Date != filteredTasks[j].Date {
+			return filteredTasks[i].Date < filteredTasks[j].Date
+		}
+		return filteredTasks[i].ID < filteredTasks[j].ID
+	})
+
+	return filteredTasks, nil
+}
@@ -17,19 +17,18 @@ type TaskManagerSQLite struct {
 
 // NewTaskManagerSQLite crea un nuevo gestor de tareas con SQLite
 func NewTaskManagerSQLite() *TaskManagerSQLite {
-	configManager := NewConfigManager()
-	if err := configManager.Load(); err != nil {
-		// Si no puede cargar configuración, usar valores por defecto
-		fmt.Printf("⚠️  Warning: Could not load config: %v\n", err)
-	}
+	return newTaskManagerSQLite(LoadConfigManager())
+}
 
+// newTaskManagerSQLite crea un gestor SQLite con una configuración ya cargada
+func newTaskManagerSQLite(configManager *ConfigManager) *TaskManagerSQLite {
 	// Obtener directorio de datos
 	homeDir, _ := os.UserHomeDir()
 	dataDir := filepath.Join(homeDir, ".workflow")
 
 	dbManager := NewDatabaseManager(dataDir)
 	if err := dbManager.Init(); err != nil {
-		fmt.Printf("⚠️  Warning: Could not initialize database: %v\n", err)
+		fmt.Fprintf(os.Stderr, "⚠️  Warning: Could not initialize database: %v\n", err)
 	}
 
 	return &TaskManagerSQLite{
@@ -55,7 +54,7 @@ func (tm *TaskManagerSQLite) AddTask(description string, hours float64, category
 	// Usar fecha proporcionada o fecha actual
 	taskDate := date
 	if taskDate == "" {
-		taskDate = time.Now().Format("2006-01-02")
+		taskDate = Today()
 	}
 
 	// Crear nueva tarea
@@ -65,13 +64,18 @@ func (tm *TaskManagerSQLite) AddTask(description string, hours float64, category
 		Category:    category,
 		Date:        taskDate,
 		Status:      workflow.StatusPending,
-		CreatedAt:   time.Now(),
+		CreatedAt:   time.Now().UTC(),
 	}
 
 	// Guardar en la base de datos
 	return tm.dbManager.SaveTask(newTask)
 }
 
+// CreateTask guarda una tarea ya construida asignándole un nuevo ID
+func (tm *TaskManagerSQLite) CreateTask(task *workflow.Task) error {
+	return tm.dbManager.SaveTask(task)
+}
+
 // UpdateTask actualiza una tarea existente por ID
 func (tm *TaskManagerSQLite) UpdateTask(id int, description string, hours float64, category string) error {
 	// Obtener la tarea actual
@@ -95,7 +99,12 @@ func (tm *TaskManagerSQLite) UpdateTask(id int, description string, hours float6
 	return tm.dbManager.UpdateTask(task)
 }
 
-// DeleteTask elimina una tarea por ID
+// ReplaceTask guarda todos los campos de una tarea existente
+func (tm *TaskManagerSQLite) ReplaceTask(task *workflow.Task) error {
+	return tm.dbManager.UpdateTask(task)
+}
+
+// DeleteTask envía una tarea a la papelera por ID
 func (tm *TaskManagerSQLite) DeleteTask(id int) error {
 	return tm.dbManager.DeleteTask(id)
 }
@@ -105,9 +114,14 @@ func (tm *TaskManagerSQLite) GetTaskByID(id int) (*workflow.Task, error) {
 	return tm.dbManager.GetTaskByID(id)
 }
 
+// GetTasksByIDs obtiene las tareas con los IDs indicados, ordenadas por ID
+func (tm *TaskManagerSQLite) GetTasksByIDs(ids []int) ([]workflow.Task, error) {
+	return tm.dbManager.GetTasksByIDs(ids)
+}
+
 // GetTodayTasks obtiene las tareas del día actual
 func (tm *TaskManagerSQLite) GetTodayTasks() ([]workflow.Task, error) {
-	today := time.Now().Format("2006-01-02")
+	today := Today()
 	return tm.dbManager.GetTasksByDate(today)
 }
 
@@ -121,6 +135,11 @@ func (tm *TaskManagerSQLite) SearchTasks(query string, category string, status s
 	return tm.dbManager.SearchTasks(query, category, status, date)
 }
 
+// SearchTasksInRange busca tareas entre dos fechas aplicando los filtros indicados
+func (tm *TaskManagerSQLite) SearchTasksInRange(filter TaskFilter) ([]workflow.Task, error) {
+	return tm.dbManager.SearchTasksInRange(filter)
+}
+
 // CompleteTask marca una tarea como completada
 func (tm *TaskManagerSQLite) CompleteTask(id int) error {
 	// Obtener la tarea actual
@@ -145,24 +164,20 @@ func (tm *TaskManagerSQLite) UpdateTaskStatus(id int, status string) error {
 	}
 
 	// Validar estado
-	validStatuses := []string{workflow.StatusPending, workflow.StatusInProgress, workflow.StatusCompleted, workflow.StatusPaused}
-	isValid := false
-	for _, validStatus := range validStatuses {
-		if status == validStatus {
-			isValid = true
-			break
-		}
-	}
-
-	if !isValid {
-		return fmt.Errorf("invalid status: %s. Valid statuses are: %v", status, validStatuses)
+	if !isValidStatus(status) {
+		return fmt.Errorf("invalid status: %s. Valid statuses are: %v", status, validStatuses())
 	}
 
 	// Actualizar estado
 	task.Status = status
 
 	// Guardar cambios
-	return tm.dbManager.UpdateTask(task)
+	if err := tm.dbManager.UpdateTask(task); err != nil {
+		return err
+	}
+
+	// Pausar o reanudar el temporizador vinculado a la tarea
+	return tm.syncTimerWithStatus(id, status)
 }
 
 // GetTotalHours calcula el total de horas de una lista de tareas
@@ -198,3 +213,13 @@ func (tm *TaskManagerSQLite) GetDatabasePath() string {
 func (tm *TaskManagerSQLite) SaveTaskToDatabase(task *workflow.Task) error {
 	return tm.dbManager.SaveTask(task)
 }
+
+// GetMigrationStatus devuelve el estado de las migraciones del esquema
+func (tm *TaskManagerSQLite) GetMigrationStatus() ([]MigrationStatus, error) {
+	return tm.dbManager.MigrationStatus()
+}
+
+// GetSchemaVersion devuelve la versión actual del esquema de la base de datos
+func (tm *TaskManagerSQLite) GetSchemaVersion() (int, error) {
+	return tm.dbManager.SchemaVersion()
+}
@@ -0,0 +1,213 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// workedTaskColumns devuelve cada tarea una vez por día trabajado, con las horas de ese día.
+// Respeta el orden de taskColumns para poder reutilizar scanTask.
+const workedTaskColumns = `t.id AS task_id, t.description, SUM(e.hours), t.category, e.date AS worked_date, t.status, t.created_at, COALESCE(p.name, ''), COALESCE(c.name, ''), ` + taskTagsColumn + `, t.billable, t.deleted_at`
+
+// unworkedTaskColumns devuelve las tareas sin horas registradas en su fecha, con las mismas columnas que workedTaskColumns
+const unworkedTaskColumns = `t.id AS task_id, t.description, 0, t.category, t.date AS worked_date, t.status, t.created_at, COALESCE(p.name, ''), COALESCE(c.name, ''), ` + taskTagsColumn + `, t.billable, t.deleted_at`
+
+// timeEntryColumns son las columnas leídas por scanTimeEntries, en orden
+const timeEntryColumns = `id, task_id, date, start_time, end_time, hours, note`
+
+// AddTimeEntry registra horas sobre una tarea existente y recalcula su total
+func (dm *DatabaseManager) AddTimeEntry(entry *workflow.TimeEntry) error {
+	return dm.withTx(func(tx *sql.Tx) error {
+		return logTimeEntry(tx, entry)
+	})
+}
+
+// logTimeEntry inserta un registro de horas, recalcula el total y lo anota en el historial
+func logTimeEntry(tx *sql.Tx, entry *workflow.TimeEntry) error {
+	before, err := getTaskTx(tx, entry.TaskID)
+	if err != nil {
+		return err
+	}
+	if before == nil || before.InTrash() {
+		return fmt.Errorf("task with ID %d not found", entry.TaskID)
+	}
+
+	if err := insertTimeEntry(tx, entry); err != nil {
+		return err
+	}
+
+	if err := refreshTaskHours(tx, entry.TaskID); err != nil {
+		return err
+	}
+
+	after, err := getTaskTx(tx, entry.TaskID)
+	if err != nil {
+		return err
+	}
+
+	return recordHistory(tx, historyRecord{
+		TaskID:    entry.TaskID,
+		Operation: workflow.HistoryLog,
+		Before:    before,
+		After:     after,
+		EntryID:   entry.ID,
+	})
+}
+
+// GetTimeEntries devuelve los registros de horas de una tarea ordenados por fecha
+func (dm *DatabaseManager) GetTimeEntries(taskID int) ([]workflow.TimeEntry, error) {
+	rows, err := dm.db.Query(`SELECT `+timeEntryColumns+` FROM time_entries WHERE task_id = ? ORDER BY date, id`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("could not query time entries: %v", err)
+	}
+	defer rows.Close()
+
+	return scanTimeEntries(rows)
+}
+
+// getTimeEntriesTx devuelve los registros de horas de una tarea dentro de una transacción
+func getTimeEntriesTx(tx *sql.Tx, taskID int) ([]workflow.TimeEntry, error) {
+	rows, err := tx.Query(`SELECT `+timeEntryColumns+` FROM time_entries WHERE task_id = ? ORDER BY date, id`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("could not query time entries: %v", err)
+	}
+	defer rows.Close()
+
+	return scanTimeEntries(rows)
+}
+
+// scanTimeEntries lee los registros de horas de un conjunto de filas
+func scanTimeEntries(rows *sql.Rows) ([]workflow.TimeEntry, error) {
+	var entries []workflow.TimeEntry
+	for rows.Next() {
+		var entry workflow.TimeEntry
+		var start, end, note sql.NullString
+
+		if err := rows.Scan(&entry.ID, &entry.TaskID, &entry.Date, &start, &end, &entry.Hours, &note); err != nil {
+			return nil, fmt.Errorf("could not scan time entry: %v", err)
+		}
+
+		entry.Start, _ = time.Parse(time.RFC3339Nano, start.String)
+		entry.End, _ = time.Parse(time.RFC3339Nano, end.String)
+		entry.Note = note.String
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+// insertTimeEntry inserta un registro de horas sin recalcular el total de la tarea
+func insertTimeEntry(tx *sql.Tx, entry *workflow.TimeEntry) error {
+	// Un ID distinto de cero se conserva, por ejemplo al restaurar una tarea borrada
+	var id interface{}
+	if entry.ID != 0 {
+		id = entry.ID
+	}
+
+	query := `
+	INSERT INTO time_entries (id, task_id, date, start_time, end_time, hours, note)
+	VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := tx.Exec(query, id, entry.TaskID, entry.Date, formatOptionalTime(entry.Start), formatOptionalTime(entry.End), entry.Hours, entry.Note)
+	if err != nil {
+		return fmt.Errorf("could not insert time entry: %v", err)
+	}
+
+	insertedID, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("could not get last insert id: %v", err)
+	}
+
+	entry.ID = int(insertedID)
+	return nil
+}
+
+// refreshTaskHours recalcula las horas de una tarea como la suma de sus registros
+func refreshTaskHours(tx *sql.Tx, taskID int) error {
+	query := `UPDATE tasks SET hours = (SELECT COALESCE(SUM(hours), 0) FROM time_entries WHERE task_id = ?) WHERE id = ?`
+
+	if _, err := tx.Exec(query, taskID, taskID); err != nil {
+		return fmt.Errorf("could not refresh task hours: %v", err)
+	}
+
+	return nil
+}
+
+// adjustTaskHours hace que los registros de una tarea sumen task.Hours.
+// Con un único registro se corrige ese registro; con varios se agrega un ajuste
+// en la fecha de la tarea para no perder el historial.
+func adjustTaskHours(tx *sql.Tx, task *workflow.Task) error {
+	var current float64
+	var count int
+	if err := tx.QueryRow(`SELECT COALESCE(SUM(hours), 0), COUNT(*) FROM time_entries WHERE task_id = ?`, task.ID).Scan(&current, &count); err != nil {
+		return fmt.Errorf("could not read time entries: %v", err)
+	}
+
+	delta := task.Hours - current
+	if math.Abs(delta) > 1e-9 {
+		switch count {
+		case 0:
+			if err := insertTimeEntry(tx, &workflow.TimeEntry{TaskID: task.ID, Date: task.Date, Hours: task.Hours}); err != nil {
+				return err
+			}
+		case 1:
+			if _, err := tx.Exec(`UPDATE time_entries SET hours = ? WHERE task_id = ?`, task.Hours, task.ID); err != nil {
+				return fmt.Errorf("could not update time entry: %v", err)
+			}
+		default:
+			if err := insertTimeEntry(tx, &workflow.TimeEntry{TaskID: task.ID, Date: task.Date, Hours: delta, Note: "manual adjustment"}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return refreshTaskHours(tx, task.ID)
+}
+
+// nullableTime convierte una fecha sin definir en NULL
+func nullableTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UTC()
+}
+
+// formatOptionalTime guarda una hora en UTC o NULL si no está definida
+func formatOptionalTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// AddTimeEntry registra horas sobre una tarea existente
+func (tm *TaskManagerSQLite) AddTimeEntry(taskID int, hours float64, date string, note string) (*workflow.TimeEntry, error) {
+	// Usar fecha proporcionada o fecha actual
+	if date == "" {
+		date = Today()
+	}
+
+	entry := &workflow.TimeEntry{
+		TaskID: taskID,
+		Date:   date,
+		Hours:  hours,
+		Note:   note,
+	}
+
+	if err := tm.dbManager.AddTimeEntry(entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// GetTimeEntries devuelve los registros de horas de una tarea
+func (tm *TaskManagerSQLite) GetTimeEntries(taskID int) ([]workflow.TimeEntry, error) {
+	return tm.dbManager.GetTimeEntries(taskID)
+}
@@ -0,0 +1,375 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// GetActiveTimer devuelve el temporizador en curso o nil si no hay ninguno
+func (dm *DatabaseManager) GetActiveTimer() (*workflow.Timer, error) {
+	query := `SELECT description, category, task_id, started_at, resumed_at, accumulated_seconds, paused FROM timers WHERE id = 1`
+
+	var timer workflow.Timer
+	var taskID sql.NullInt64
+	var startedAt, resumedAt string
+	var accumulated float64
+
+	err := dm.db.QueryRow(query).Scan(&timer.Description, &timer.Category, &taskID, &startedAt, &resumedAt, &accumulated, &timer.Paused)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("could not read timer: %v", err)
+	}
+
+	timer.TaskID = int(taskID.Int64)
+	timer.StartedAt, _ = time.Parse(time.RFC3339Nano, startedAt)
+	timer.ResumedAt, _ = time.Parse(time.RFC3339Nano, resumedAt)
+	timer.Accumulated = time.Duration(accumulated * float64(time.Second))
+
+	return &timer, nil
+}
+
+// SaveTimer crea o reemplaza el temporizador en curso
+func (dm *DatabaseManager) SaveTimer(timer *workflow.Timer) error {
+	query := `
+	INSERT OR REPLACE INTO timers (id, description, category, task_id, started_at, resumed_at, accumulated_seconds, paused)
+	VALUES (1, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	if _, err := dm.db.Exec(query, timerValues(timer)...); err != nil {
+		return fmt.Errorf("could not save timer: %v", err)
+	}
+
+	return nil
+}
+
+// StartTimer crea el temporizador y marca en curso la tarea vinculada, si la hay, en una sola transacción.
+// Falla si ya hay un temporizador en marcha.
+func (dm *DatabaseManager) StartTimer(timer *workflow.Timer, task *workflow.Task) error {
+	return dm.withTx(func(tx *sql.Tx) error {
+		var running string
+		err := tx.QueryRow(`SELECT description FROM timers WHERE id = 1`).Scan(&running)
+		if err == nil {
+			return fmt.Errorf("a timer is already running for '%s' (run 'workflow stop' first)", running)
+		}
+		if err != sql.ErrNoRows {
+			return fmt.Errorf("could not read timer: %v", err)
+		}
+
+		if task != nil {
+			task.Status = workflow.StatusInProgress
+			if err := updateTask(tx, task); err != nil {
+				return err
+			}
+		}
+
+		// Sin OR REPLACE: si otro proceso arrancó un temporizador a la vez, la clave primaria lo rechaza
+		query := `
+		INSERT INTO timers (id, description, category, task_id, started_at, resumed_at, accumulated_seconds, paused)
+		VALUES (1, ?, ?, ?, ?, ?, ?, ?)
+		`
+		if _, err := tx.Exec(query, timerValues(timer)...); err != nil {
+			return fmt.Errorf("could not start timer: %v", err)
+		}
+
+		return nil
+	})
+}
+
+// timerValues devuelve los valores de las columnas de un temporizador, salvo el id
+func timerValues(timer *workflow.Timer) []interface{} {
+	var taskID interface{}
+	if timer.TaskID != 0 {
+		taskID = timer.TaskID
+	}
+
+	return []interface{}{
+		timer.Description,
+		timer.Category,
+		taskID,
+		timer.StartedAt.UTC().Format(time.RFC3339Nano),
+		timer.ResumedAt.UTC().Format(time.RFC3339Nano),
+		timer.Accumulated.Seconds(),
+		timer.Paused,
+	}
+}
+
+// DeleteTimer elimina el temporizador en curso sin registrar horas
+func (dm *DatabaseManager) DeleteTimer() error {
+	if _, err := dm.db.Exec(`DELETE FROM timers WHERE id = 1`); err != nil {
+		return fmt.Errorf("could not delete timer: %v", err)
+	}
+	return nil
+}
+
+// FinishTimer registra el tramo medido sobre la tarea (creándola si es nueva)
+// y elimina el temporizador en una sola transacción
+func (dm *DatabaseManager) FinishTimer(task *workflow.Task, entry *workflow.TimeEntry) error {
+	return dm.withTx(func(tx *sql.Tx) error {
+		if task.ID == 0 {
+			hours := task.Hours
+			task.Hours = 0
+			if err := insertTask(tx, task); err != nil {
+				return err
+			}
+			task.Hours = hours
+		}
+
+		entry.TaskID = task.ID
+		if err := logTimeEntry(tx, entry); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(`DELETE FROM timers WHERE id = 1`); err != nil {
+			return fmt.Errorf("could not delete timer: %v", err)
+		}
+
+		return nil
+	})
+}
+
+// GetActiveTimer devuelve el temporizador en curso o nil si no hay ninguno
+func (tm *TaskManagerSQLite) GetActiveTimer() (*workflow.Timer, error) {
+	return tm.dbManager.GetActiveTimer()
+}
+
+// StartTimer inicia un temporizador nuevo o vinculado a una tarea existente (taskID != 0)
+func (tm *TaskManagerSQLite) StartTimer(description string, category string, taskID int) (*workflow.Timer, error) {
+	var task *workflow.Task
+	if taskID != 0 {
+		var err error
+		task, err = tm.dbManager.GetTaskByID(taskID)
+		if err != nil {
+			return nil, err
+		}
+		description = task.Description
+		category = task.Category
+	} else if err := tm.configManager.ValidateCategory(category); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	timer := &workflow.Timer{
+		Description: description,
+		Category:    category,
+		TaskID:      taskID,
+		StartedAt:   now,
+		ResumedAt:   now,
+	}
+
+	if err := tm.dbManager.StartTimer(timer, task); err != nil {
+		return nil, err
+	}
+
+	return timer, nil
+}
+
+// StopTimer detiene el temporizador y convierte el tiempo medido en horas de una tarea
+func (tm *TaskManagerSQLite) StopTimer() (*workflow.Task, error) {
+	timer, err := tm.dbManager.GetActiveTimer()
+	if err != nil {
+		return nil, err
+	}
+	if timer == nil {
+		return nil, fmt.Errorf("no timer is running")
+	}
+
+	now := time.Now()
+	increment, mode := tm.configManager.GetTimerRounding()
+	hours := RoundHours(timer.Elapsed(now).Hours(), increment, mode)
+
+	// Si la tarea vinculada se borró, el tiempo medido se guarda en una tarea nueva
+	task, err := tm.linkedTask(timer)
+	if err != nil {
+		return nil, err
+	}
+	if task != nil {
+		task.Hours += hours
+	} else {
+		task = &workflow.Task{
+			Description: timer.Description,
+			Hours:       hours,
+			Category:    timer.Category,
+			Date:        DateOf(timer.StartedAt),
+			Status:      workflow.StatusPending,
+			CreatedAt:   timer.StartedAt,
+		}
+	}
+
+	// El tramo se registra en el día en que arrancó el temporizador
+	entry := &workflow.TimeEntry{
+		Date:  DateOf(timer.StartedAt),
+		Start: timer.StartedAt,
+		End:   now,
+		Hours: hours,
+	}
+
+	if err := tm.dbManager.FinishTimer(task, entry); err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+// PauseTimer pausa el temporizador en curso y la tarea vinculada, si la hay
+func (tm *TaskManagerSQLite) PauseTimer() (*workflow.Timer, error) {
+	timer, err := tm.setTimerPaused(true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tm.setLinkedTaskStatus(timer, workflow.StatusPaused); err != nil {
+		return nil, err
+	}
+
+	return timer, nil
+}
+
+// ResumeTimer reanuda el temporizador pausado y la tarea vinculada, si la hay
+func (tm *TaskManagerSQLite) ResumeTimer() (*workflow.Timer, error) {
+	timer, err := tm.setTimerPaused(false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tm.setLinkedTaskStatus(timer, workflow.StatusInProgress); err != nil {
+		return nil, err
+	}
+
+	return timer, nil
+}
+
+// setTimerPaused cambia el estado de pausa del temporizador acumulando el tiempo medido
+func (tm *TaskManagerSQLite) setTimerPaused(paused bool) (*workflow.Timer, error) {
+	timer, err := tm.dbManager.GetActiveTimer()
+	if err != nil {
+		return nil, err
+	}
+	if timer == nil {
+		return nil, fmt.Errorf("no timer is running")
+	}
+	if timer.Paused == paused {
+		return timer, nil
+	}
+
+	now := time.Now()
+	if paused {
+		timer.Accumulated = timer.Elapsed(now)
+	} else {
+		timer.ResumedAt = now
+	}
+	timer.Paused = paused
+
+	if err := tm.dbManager.SaveTimer(timer); err != nil {
+		return nil, err
+	}
+
+	return timer, nil
+}
+
+// setLinkedTaskStatus actualiza el estado de la tarea vinculada al temporizador
+func (tm *TaskManagerSQLite) setLinkedTaskStatus(timer *workflow.Timer, status string) error {
+	task, err := tm.linkedTask(timer)
+	if err != nil || task == nil {
+		return err
+	}
+
+	task.Status = status
+	return tm.dbManager.UpdateTask(task)
+}
+
+// linkedTask devuelve la tarea vinculada al temporizador, o nil si no tiene
+// o si la tarea ya no existe o está en la papelera
+func (tm *TaskManagerSQLite) linkedTask(timer *workflow.Timer) (*workflow.Task, error) {
+	if timer.TaskID == 0 {
+		return nil, nil
+	}
+
+	var task *workflow.Task
+	err := tm.dbManager.withTx(func(tx *sql.Tx) error {
+		var err error
+		task, err = getTaskTx(tx, timer.TaskID)
+		return err
+	})
+	if err != nil || task == nil || task.InTrash() {
+		return nil, err
+	}
+	return task, nil
+}
+
+// CancelTimer descarta el temporizador en curso sin registrar horas.
+// La tarea vinculada que estaba en curso o pausada vuelve a quedar pendiente.
+func (tm *TaskManagerSQLite) CancelTimer() (*workflow.Timer, error) {
+	timer, err := tm.dbManager.GetActiveTimer()
+	if err != nil {
+		return nil, err
+	}
+	if timer == nil {
+		return nil, fmt.Errorf("no timer is running")
+	}
+
+	task, err := tm.linkedTask(timer)
+	if err != nil {
+		return nil, err
+	}
+	if task != nil && (task.Status == workflow.StatusInProgress || task.Status == workflow.StatusPaused) {
+		task.Status = workflow.StatusPending
+		if err := tm.dbManager.UpdateTask(task); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tm.dbManager.DeleteTimer(); err != nil {
+		return nil, err
+	}
+	return timer, nil
+}
+
+// syncTimerWithStatus pausa o reanuda el temporizador cuando cambia el estado de su tarea.
+// Completar la tarea también lo pausa: el tiempo medido se registra con 'workflow stop'.
+func (tm *TaskManagerSQLite) syncTimerWithStatus(taskID int, status string) error {
+	timer, err := tm.dbManager.GetActiveTimer()
+	if err != nil || timer == nil || timer.TaskID != taskID {
+		return err
+	}
+
+	switch status {
+	case workflow.StatusPaused, workflow.StatusCompleted:
+		_, err = tm.setTimerPaused(true)
+	case workflow.StatusInProgress:
+		_, err = tm.setTimerPaused(false)
+	}
+
+	return err
+}
+
+// RoundHours redondea horas al incremento en minutos indicado.
+// Un tiempo medido mayor que cero nunca se redondea por debajo de un incremento.
+func RoundHours(hours float64, incrementMinutes int, mode string) float64 {
+	if incrementMinutes <= 0 {
+		return math.Round(hours*100) / 100
+	}
+
+	increment := float64(incrementMinutes) / 60
+	steps := hours / increment
+
+	switch mode {
+	case workflow.RoundUp:
+		steps = math.Ceil(steps)
+	case workflow.RoundDown:
+		steps = math.Floor(steps)
+	default:
+		steps = math.Round(steps)
+	}
+
+	if steps < 1 && hours > 0 {
+		steps = 1
+	}
+
+	return math.Round(steps*increment*100) / 100
+}
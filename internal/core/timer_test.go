@@ -0,0 +1,156 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// TestRoundHours comprueba el redondeo por incrementos en cada modo
+func TestRoundHours(t *testing.T) {
+	tests := []struct {
+		name      string
+		hours     float64
+		increment int
+		mode      string
+		want      float64
+	}{
+		{name: "no increment keeps cents", hours: 1.234, increment: 0, mode: workflow.RoundNearest, want: 1.23},
+		{name: "nearest down", hours: 1.1, increment: 15, mode: workflow.RoundNearest, want: 1.0},
+		{name: "nearest up", hours: 1.13, increment: 15, mode: workflow.RoundNearest, want: 1.25},
+		{name: "unknown mode rounds to nearest", hours: 1.13, increment: 15, mode: "", want: 1.25},
+		{name: "up", hours: 1.01, increment: 15, mode: workflow.RoundUp, want: 1.25},
+		{name: "up on exact increment", hours: 2.0, increment: 60, mode: workflow.RoundUp, want: 2.0},
+		{name: "down", hours: 1.24, increment: 15, mode: workflow.RoundDown, want: 1.0},
+		{name: "short time is one increment", hours: 0.05, increment: 15, mode: workflow.RoundNearest, want: 0.25},
+		{name: "short time rounding down is one increment", hours: 0.05, increment: 15, mode: workflow.RoundDown, want: 0.25},
+		{name: "zero stays zero", hours: 0, increment: 15, mode: workflow.RoundUp, want: 0},
+		{name: "six minute increments", hours: 0.52, increment: 6, mode: workflow.RoundNearest, want: 0.5},
+		{name: "ten minute increments", hours: 7.0 / 60, increment: 10, mode: workflow.RoundNearest, want: 0.17},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RoundHours(tt.hours, tt.increment, tt.mode); got != tt.want {
+				t.Errorf("RoundHours(%v, %d, %q) = %v, want %v", tt.hours, tt.increment, tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+// newTestTaskManager crea un gestor SQLite sobre una base de datos temporal con la configuración por defecto
+func newTestTaskManager(t *testing.T) *TaskManagerSQLite {
+	t.Helper()
+	homeDir := t.TempDir()
+	return &TaskManagerSQLite{
+		configManager: &ConfigManager{config: getDefaultConfig(homeDir)},
+		dbManager:     newTestDatabase(t),
+	}
+}
+
+// TestApplyBatchSyncsTimer comprueba que cambiar el estado de la tarea del temporizador lo pausa o reanuda
+func TestApplyBatchSyncsTimer(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		linked     bool
+		wantPaused bool
+	}{
+		{name: "paused task pauses the timer", status: workflow.StatusPaused, linked: true, wantPaused: true},
+		{name: "completed task pauses the timer", status: workflow.StatusCompleted, linked: true, wantPaused: true},
+		{name: "pending task leaves the timer running", status: workflow.StatusPending, linked: true, wantPaused: false},
+		{name: "other tasks leave the timer running", status: workflow.StatusPaused, linked: false, wantPaused: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTaskManager(t)
+			timed := saveTestTask(t, tm.dbManager, "timed", 1, "2025-07-01")
+			other := saveTestTask(t, tm.dbManager, "other", 1, "2025-07-01")
+			if _, err := tm.StartTimer("", "", timed); err != nil {
+				t.Fatalf("StartTimer error: %v", err)
+			}
+
+			id := other
+			if tt.linked {
+				id = timed
+			}
+			task, err := tm.GetTaskByID(id)
+			if err != nil {
+				t.Fatalf("GetTaskByID error: %v", err)
+			}
+			task.Status = tt.status
+			if err := tm.ApplyBatch(TaskBatch{Update: []*workflow.Task{task}}); err != nil {
+				t.Fatalf("ApplyBatch error: %v", err)
+			}
+
+			timer, err := tm.GetActiveTimer()
+			if err != nil || timer == nil {
+				t.Fatalf("GetActiveTimer = %v, %v", timer, err)
+			}
+			if timer.Paused != tt.wantPaused {
+				t.Errorf("timer paused = %v, want %v", timer.Paused, tt.wantPaused)
+			}
+		})
+	}
+
+	t.Run("in progress task resumes the paused timer", func(t *testing.T) {
+		tm := newTestTaskManager(t)
+		timed := saveTestTask(t, tm.dbManager, "timed", 1, "2025-07-01")
+		if _, err := tm.StartTimer("", "", timed); err != nil {
+			t.Fatalf("StartTimer error: %v", err)
+		}
+		if _, err := tm.PauseTimer(); err != nil {
+			t.Fatalf("PauseTimer error: %v", err)
+		}
+
+		task, err := tm.GetTaskByID(timed)
+		if err != nil {
+			t.Fatalf("GetTaskByID error: %v", err)
+		}
+		task.Status = workflow.StatusInProgress
+		if err := tm.ApplyBatch(TaskBatch{Update: []*workflow.Task{task}}); err != nil {
+			t.Fatalf("ApplyBatch error: %v", err)
+		}
+
+		timer, err := tm.GetActiveTimer()
+		if err != nil || timer == nil {
+			t.Fatalf("GetActiveTimer = %v, %v", timer, err)
+		}
+		if timer.Paused {
+			t.Errorf("timer is still paused")
+		}
+	})
+}
+
+// TestStartTimer comprueba que solo corre un temporizador y que su categoría debe existir
+func TestStartTimer(t *testing.T) {
+	tm := newTestTaskManager(t)
+
+	if _, err := tm.StartTimer("Unknown", "no-such-category", 0); err == nil {
+		t.Fatalf("StartTimer with an unknown category succeeded")
+	}
+
+	task := saveTestTask(t, tm.dbManager, "timed", 1, "2025-07-01")
+	if _, err := tm.StartTimer("", "", task); err != nil {
+		t.Fatalf("StartTimer error: %v", err)
+	}
+	linked, err := tm.GetTaskByID(task)
+	if err != nil {
+		t.Fatalf("GetTaskByID error: %v", err)
+	}
+	if linked.Status != workflow.StatusInProgress {
+		t.Errorf("linked task status = %q, want %q", linked.Status, workflow.StatusInProgress)
+	}
+
+	if _, err := tm.StartTimer("Second", "tech", 0); err == nil {
+		t.Fatalf("second StartTimer succeeded")
+	}
+	timer, err := tm.GetActiveTimer()
+	if err != nil || timer == nil {
+		t.Fatalf("GetActiveTimer = %v, %v", timer, err)
+	}
+	if timer.TaskID != task {
+		t.Errorf("running timer task = %d, want %d", timer.TaskID, task)
+	}
+}
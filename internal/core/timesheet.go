@@ -0,0 +1,107 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// Agrupaciones de filas de la hoja de horas
+const (
+	TimesheetByCategory    = "category"
+	TimesheetByProject     = "project"
+	TimesheetByDescription = "description"
+)
+
+// Timesheet es una grilla de horas con una fila por grupo y una columna por día
+type Timesheet struct {
+	From      string
+	To        string
+	GroupBy   string
+	Dates     []string
+	Rows      []TimesheetRow
+	DayTotals []float64
+	Total     float64
+}
+
+// TimesheetRow son las horas de un grupo en cada día de la hoja
+type TimesheetRow struct {
+	Name  string
+	Hours []float64 // una posición por cada fecha de Timesheet.Dates
+	Total float64
+}
+
+// NewTimesheet arma la hoja de horas a partir de los días de un reporte con From y To definidos
+func NewTimesheet(report *Report, groupBy string) (*Timesheet, error) {
+	var name func(workflow.Task) string
+	switch groupBy {
+	case TimesheetByCategory:
+		name = func(task workflow.Task) string { return task.Category }
+	case TimesheetByProject:
+		name = projectGroupName
+	case TimesheetByDescription:
+		name = func(task workflow.Task) string { return task.Description }
+	default:
+		return nil, fmt.Errorf("invalid group: %s (use %s, %s or %s)", groupBy, TimesheetByCategory, TimesheetByProject, TimesheetByDescription)
+	}
+
+	from, err := time.Parse("2006-01-02", report.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timesheet start: %v", err)
+	}
+	to, err := time.Parse("2006-01-02", report.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timesheet end: %v", err)
+	}
+
+	timesheet := &Timesheet{From: report.From, To: report.To, GroupBy: groupBy}
+	column := make(map[string]int)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		column[day.Format("2006-01-02")] = len(timesheet.Dates)
+		timesheet.Dates = append(timesheet.Dates, day.Format("2006-01-02"))
+	}
+	timesheet.DayTotals = make([]float64, len(timesheet.Dates))
+
+	rows := make(map[string]*TimesheetRow)
+	for _, day := range report.Days {
+		i, exists := column[day.Date]
+		if !exists {
+			continue
+		}
+		for _, task := range day.Tasks {
+			row, exists := rows[name(task)]
+			if !exists {
+				row = &TimesheetRow{Name: name(task), Hours: make([]float64, len(timesheet.Dates))}
+				rows[row.Name] = row
+			}
+			row.Hours[i] += task.Hours
+			row.Total += task.Hours
+			timesheet.DayTotals[i] += task.Hours
+			timesheet.Total += task.Hours
+		}
+	}
+
+	for _, row := range rows {
+		timesheet.Rows = append(timesheet.Rows, *row)
+	}
+	sort.Slice(timesheet.Rows, func(i, j int) bool {
+		return timesheet.Rows[i].Name < timesheet.Rows[j].Name
+	})
+
+	// Redondear a centésimos para que las sumas de la grilla coincidan con lo que se muestra
+	for i := range timesheet.Rows {
+		row := &timesheet.Rows[i]
+		for j := range row.Hours {
+			row.Hours[j] = roundCents(row.Hours[j])
+		}
+		row.Total = roundCents(row.Total)
+	}
+	for i := range timesheet.DayTotals {
+		timesheet.DayTotals[i] = roundCents(timesheet.DayTotals[i])
+	}
+	timesheet.Total = roundCents(timesheet.Total)
+
+	return timesheet, nil
+}
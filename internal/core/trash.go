@@ -0,0 +1,190 @@
+package core
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lucasvidela94/workflow-cli/pkg/workflow"
+)
+
+// setTaskTrashed envía una tarea a la papelera o la restaura, registrando el cambio en el historial
+func setTaskTrashed(tx *sql.Tx, id int, trashed bool) error {
+	before, err := getTaskTx(tx, id)
+	if err != nil {
+		return err
+	}
+	if before == nil || (trashed && before.InTrash()) {
+		return fmt.Errorf("task with ID %d not found", id)
+	}
+	if !trashed && !before.InTrash() {
+		return fmt.Errorf("task with ID %d is not in the trash", id)
+	}
+
+	// Una tarea con el temporizador en marcha no se puede borrar: el tiempo medido quedaría sin tarea
+	if trashed {
+		var timers int
+		if err := tx.QueryRow(`SELECT COUNT(*) FROM timers WHERE task_id = ?`, id).Scan(&timers); err != nil {
+			return fmt.Errorf("could not query timer: %v", err)
+		}
+		if timers > 0 {
+			return fmt.Errorf("task %d has a running timer (run 'workflow stop' or 'workflow timer cancel' first)", id)
+		}
+	}
+
+	var deletedAt interface{}
+	operation := workflow.HistoryRestore
+	if trashed {
+		deletedAt = time.Now().UTC()
+		operation = workflow.HistoryTrash
+	}
+
+	if _, err := tx.Exec(`UPDATE tasks SET deleted_at = ? WHERE id = ?`, deletedAt, id); err != nil {
+		return fmt.Errorf("could not update task trash state: %v", err)
+	}
+
+	after, err := getTaskTx(tx, id)
+	if err != nil {
+		return err
+	}
+
+	return recordHistory(tx, historyRecord{TaskID: id, Operation: operation, Before: before, After: after})
+}
+
+// ListTrash devuelve las tareas de la papelera, las borradas más recientemente primero
+func (dm *DatabaseManager) ListTrash() ([]workflow.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM ` + taskTables + ` WHERE t.deleted_at IS NOT NULL ORDER BY t.deleted_at DESC, t.id DESC`
+
+	rows, err := dm.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("could not query trash: %v", err)
+	}
+	defer rows.Close()
+
+	return scanTasks(rows)
+}
+
+// RestoreTask saca una tarea de la papelera
+func (dm *DatabaseManager) RestoreTask(id int) error {
+	return dm.withTx(func(tx *sql.Tx) error {
+		return setTaskTrashed(tx, id, false)
+	})
+}
+
+// EmptyTrash elimina definitivamente las tareas enviadas a la papelera antes de la fecha indicada.
+// La purga no se anota en el historial y también borra el historial de esas tareas, que ya no se puede deshacer.
+func (dm *DatabaseManager) EmptyTrash(before time.Time) (int, error) {
+	trashed, err := dm.ListTrash()
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	err = dm.withTx(func(tx *sql.Tx) error {
+		for _, task := range trashed {
+			if !task.DeletedAt.Before(before) {
+				continue
+			}
+			if err := removeTask(tx, task.ID); err != nil {
+				return err
+			}
+			if _, err := tx.Exec(`DELETE FROM task_history WHERE task_id = ?`, task.ID); err != nil {
+				return fmt.Errorf("could not delete task history: %v", err)
+			}
+			purged++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// ListTrash devuelve las tareas de la papelera
+func (tm *TaskManagerSQLite) ListTrash() ([]workflow.Task, error) {
+	return tm.dbManager.ListTrash()
+}
+
+// RestoreTask saca una tarea de la papelera
+func (tm *TaskManagerSQLite) RestoreTask(id int) error {
+	return tm.dbManager.RestoreTask(id)
+}
+
+// EmptyTrash elimina definitivamente las tareas de la papelera borradas antes de la fecha indicada
+func (tm *TaskManagerSQLite) EmptyTrash(before time.Time) (int, error) {
+	return tm.dbManager.EmptyTrash(before)
+}
+
+// ListTrash devuelve las tareas de la papelera, las borradas más recientemente primero
+func (tm *TaskManager) ListTrash() ([]workflow.Task, error) {
+	tasks, err := tm.LoadTasks()
+	if err != nil {
+		return nil, err
+	}
+
+	var trashed []workflow.Task
+	for _, task := range tasks {
+		if task.InTrash() {
+			trashed = append(trashed, task)
+		}
+	}
+
+	sort.Slice(trashed, func(i, j int) bool {
+		return trashed[i].DeletedAt.After(trashed[j].DeletedAt)
+	})
+
+	return trashed, nil
+}
+
+// RestoreTask saca una tarea de la papelera
+func (tm *TaskManager) RestoreTask(id int) error {
+	tasks, err := tm.LoadTasks()
+	if err != nil {
+		return fmt.Errorf("could not load tasks: %v", err)
+	}
+
+	for i := range tasks {
+		if tasks[i].ID == id && tasks[i].InTrash() {
+			tasks[i].DeletedAt = time.Time{}
+
+			if err := tm.SaveTasks(tasks); err != nil {
+				return fmt.Errorf("could not save tasks: %v", err)
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("task with ID %d is not in the trash", id)
+}
+
+// EmptyTrash elimina definitivamente las tareas de la papelera borradas antes de la fecha indicada
+func (tm *TaskManager) EmptyTrash(before time.Time) (int, error) {
+	tasks, err := tm.LoadTasks()
+	if err != nil {
+		return 0, fmt.Errorf("could not load tasks: %v", err)
+	}
+
+	var kept []workflow.Task
+	for _, task := range tasks {
+		if !task.InTrash() || !task.DeletedAt.Before(before) {
+			kept = append(kept, task)
+		}
+	}
+
+	purged := len(tasks) - len(kept)
+	if purged == 0 {
+		return 0, nil
+	}
+
+	if kept == nil {
+		kept = []workflow.Task{}
+	}
+	if err := tm.SaveTasks(kept); err != nil {
+		return 0, fmt.Errorf("could not save tasks: %v", err)
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// TestEmptyTrash comprueba que la purga borre las tareas con su historial sin anotarla en él
+func TestEmptyTrash(t *testing.T) {
+	dm := newTestDatabase(t)
+
+	kept := saveTestTask(t, dm, "kept", 1, "2025-07-01")
+	purged := saveTestTask(t, dm, "purged", 1, "2025-07-01")
+	if err := dm.DeleteTask(purged); err != nil {
+		t.Fatalf("DeleteTask error: %v", err)
+	}
+
+	count, err := dm.EmptyTrash(time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("EmptyTrash error: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("EmptyTrash purged %d tasks, want 1", count)
+	}
+
+	history, err := dm.GetTaskHistory(purged)
+	if err != nil {
+		t.Fatalf("GetTaskHistory error: %v", err)
+	}
+	if len(history) != 0 {
+		t.Errorf("purged task history = %+v, want none", history)
+	}
+
+	// Deshacer sigue funcionando con las operaciones de las demás tareas
+	reverted, err := dm.Undo(1)
+	if err != nil {
+		t.Fatalf("Undo error: %v", err)
+	}
+	if len(reverted) != 1 || reverted[0].TaskID != kept {
+		t.Errorf("Undo reverted %+v, want the creation of task %d", reverted, kept)
+	}
+}
@@ -13,6 +13,111 @@ type Task struct {
 	Date        string    `json:"date"`
 	Status      string    `json:"status"`
 	CreatedAt   time.Time `json:"created_at"`
+	Project     string    `json:"project,omitempty"`
+	Client      string    `json:"client,omitempty"`
+	Tags        []string  `json:"tags,omitempty"`
+	Billable    *bool     `json:"billable,omitempty"` // nil usa el valor de la categoría
+	DeletedAt   time.Time `json:"deleted_at,omitzero"`
+}
+
+// InTrash indica si la tarea fue enviada a la papelera
+func (t Task) InTrash() bool {
+	return !t.DeletedAt.IsZero()
+}
+
+// Project agrupa tareas facturables a un cliente
+type Project struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Client    string    `json:"client"`
+	Archived  bool      `json:"archived"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TimeEntry representa un tramo de trabajo registrado sobre una tarea.
+// Start y End quedan en cero cuando las horas se registran manualmente.
+type TimeEntry struct {
+	ID     int       `json:"id"`
+	TaskID int       `json:"task_id"`
+	Date   string    `json:"date"`
+	Start  time.Time `json:"start,omitzero"`
+	End    time.Time `json:"end,omitzero"`
+	Hours  float64   `json:"hours"`
+	Note   string    `json:"note"`
+}
+
+// RecurringTemplate define una tarea que se repite según una regla.
+// StartDate es el primer día a partir del cual se generan tareas.
+type RecurringTemplate struct {
+	ID          int       `json:"id"`
+	Description string    `json:"description"`
+	Hours       float64   `json:"hours"`
+	Category    string    `json:"category"`
+	Rule        string    `json:"rule"`
+	StartDate   string    `json:"start_date"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// HistoryEntry registra un cambio sobre una tarea con su estado anterior y posterior.
+// Before es nil al crear la tarea y After es nil al borrarla.
+type HistoryEntry struct {
+	ID        int       `json:"id"`
+	TaskID    int       `json:"task_id"`
+	Operation string    `json:"operation"`
+	Before    *Task     `json:"before,omitempty"`
+	After     *Task     `json:"after,omitempty"`
+	RevertsID int       `json:"reverts_id,omitempty"`
+	Undone    bool      `json:"undone"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Operaciones registradas en el historial de tareas
+const (
+	HistoryCreate  = "create"
+	HistoryUpdate  = "update"
+	HistoryDelete  = "delete"
+	HistoryLog     = "log"
+	HistoryTrash   = "trash"
+	HistoryRestore = "restore"
+	HistoryUndo    = "undo"
+)
+
+// Leave es una ausencia (vacaciones, enfermedad o feriado personal) entre dos fechas inclusivas.
+// Hours son las horas de ausencia por día; cero significa el día completo.
+type Leave struct {
+	ID        int       `json:"id"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Type      string    `json:"type"`
+	Hours     float64   `json:"hours"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Tipos de ausencia
+const (
+	LeaveVacation = "vacation"
+	LeaveSick     = "sick"
+	LeaveHoliday  = "holiday"
+)
+
+// Timer representa un temporizador en curso que se convierte en horas de una tarea
+type Timer struct {
+	Description string        `json:"description"`
+	Category    string        `json:"category"`
+	TaskID      int           `json:"task_id"`
+	StartedAt   time.Time     `json:"started_at"`
+	ResumedAt   time.Time     `json:"resumed_at"`
+	Accumulated time.Duration `json:"accumulated"`
+	Paused      bool          `json:"paused"`
+}
+
+// Elapsed devuelve el tiempo medido por el temporizador hasta el momento indicado
+func (t *Timer) Elapsed(now time.Time) time.Duration {
+	if t.Paused {
+		return t.Accumulated
+	}
+	return t.Accumulated + now.Sub(t.ResumedAt)
 }
 
 // Estados de tareas
@@ -41,26 +146,144 @@ func GetStatusIcon(status string) string {
 
 // Config representa la configuración del usuario
 type Config struct {
-	DailyHoursTarget  float64 `json:"daily_hours_target"`
-	DailyStandupHours float64 `json:"daily_standup_hours"`
-	DataFile          string  `json:"data_file"`
-	UserName          string  `json:"user_name"`
-	Company           string  `json:"company"`
-	Timezone          string  `json:"timezone"`
+	DailyHoursTarget  float64            `json:"daily_hours_target"`
+	DailyStandupHours float64            `json:"daily_standup_hours"`
+	DataFile          string             `json:"data_file"`
+	UserName          string             `json:"user_name"`
+	Company           string             `json:"company"`
+	Timezone          string             `json:"timezone"`
+	Backend           string             `json:"backend"`
+	TimerRounding     int                `json:"timer_rounding_minutes"`
+	TimerRoundingMode string             `json:"timer_rounding_mode"`
+	Categories        []Category         `json:"categories"`
+	Shortcuts         []Shortcut         `json:"shortcuts"`
+	Calendar          WorkCalendar       `json:"calendar"`
+	LeaveAllowance    map[string]float64 `json:"leave_allowance,omitempty"` // días por año según tipo de ausencia
+	BalanceStart      string             `json:"balance_start,omitempty"`   // fecha desde la que se acumula el saldo de horas
+	Billing           Billing            `json:"billing"`
+}
+
+// Billing define las tarifas por hora y el redondeo de las horas facturadas.
+// La tarifa de una tarea es la de su proyecto, si no la de su cliente, si no la
+// de su categoría y si no DefaultRate.
+type Billing struct {
+	Currency      string             `json:"currency"`
+	DefaultRate   float64            `json:"default_rate"`
+	ProjectRates  map[string]float64 `json:"project_rates,omitempty"`
+	ClientRates   map[string]float64 `json:"client_rates,omitempty"`
+	CategoryRates map[string]float64 `json:"category_rates,omitempty"`
+	Rounding      int                `json:"rounding_minutes"`
+	RoundingMode  string             `json:"rounding_mode"`
+}
+
+// DefaultBilling devuelve la configuración de facturación sin tarifas ni redondeo
+func DefaultBilling() Billing {
+	return Billing{Currency: "USD", RoundingMode: RoundNearest}
+}
+
+// WorkCalendar define la semana laboral, las horas esperadas por día y los feriados.
+// Los días de la semana se indican por nombre en inglés ("monday" o "mon").
+type WorkCalendar struct {
+	WeekStart   string             `json:"week_start"`
+	WorkingDays []string           `json:"working_days"`
+	DailyHours  map[string]float64 `json:"daily_hours,omitempty"` // sin valor para un día se usa daily_hours_target
+	Holidays    []Holiday          `json:"holidays"`
+}
+
+// Holiday es un feriado del calendario laboral
+type Holiday struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+// DefaultWorkCalendar devuelve una semana laboral de lunes a viernes sin feriados
+func DefaultWorkCalendar() WorkCalendar {
+	return WorkCalendar{
+		WeekStart:   "monday",
+		WorkingDays: []string{"monday", "tuesday", "wednesday", "thursday", "friday"},
+	}
+}
+
+// Modos de redondeo para las horas medidas con temporizador
+const (
+	RoundNearest = "nearest"
+	RoundUp      = "up"
+	RoundDown    = "down"
+)
+
+// Category representa una categoría de tareas definida en la configuración
+type Category struct {
+	Name         string  `json:"name"`
+	Icon         string  `json:"icon"`
+	Color        string  `json:"color"`
+	Billable     bool    `json:"billable"`
+	DefaultHours float64 `json:"default_hours"`
+}
+
+// DefaultIcon es el icono usado para categorías sin icono propio
+const DefaultIcon = "📝"
+
+// DefaultCategory es la categoría de las tareas agregadas sin indicar una
+const DefaultCategory = "general"
+
+// DefaultCategories devuelve las categorías incluidas por defecto
+func DefaultCategories() []Category {
+	return []Category{
+		{Name: "tech", Icon: "💻", Color: "blue", Billable: true},
+		{Name: "meeting", Icon: "🤝", Color: "yellow", Billable: true, DefaultHours: 1.0},
+		{Name: "qa", Icon: "🧪", Color: "green", Billable: true},
+		{Name: "doc", Icon: "📚", Color: "cyan", Billable: true},
+		{Name: "planning", Icon: "📋", Color: "magenta", Billable: true},
+		{Name: "research", Icon: "🔍", Color: "cyan", Billable: true},
+		{Name: "review", Icon: "👀", Color: "magenta", Billable: true},
+		{Name: "deploy", Icon: "🚀", Color: "red", Billable: true},
+		{Name: "daily", Icon: "📢", Color: "yellow", Billable: true, DefaultHours: 0.25},
+		{Name: DefaultCategory, Icon: DefaultIcon, Color: "white", Billable: true},
+	}
+}
+
+// Shortcut define un comando rápido que agrega tareas de una categoría.
+// Description es una plantilla donde {input} se reemplaza por el texto indicado;
+// si no contiene {input} el comando no recibe descripción.
+type Shortcut struct {
+	Name        string  `json:"name"`
+	Category    string  `json:"category"`
+	Description string  `json:"description"`
+	Hours       float64 `json:"hours"`
+	Help        string  `json:"help"`
+}
+
+// ShortcutInput es el marcador de la plantilla reemplazado por la descripción indicada
+const ShortcutInput = "{input}"
+
+// DefaultShortcuts devuelve los comandos rápidos incluidos por defecto.
+// Ninguno fija horas: se resuelven al usarlos con daily_standup_hours o las horas de la categoría.
+func DefaultShortcuts() []Shortcut {
+	return []Shortcut{
+		{Name: "tech", Category: "tech", Description: ShortcutInput, Help: "Add a technical development task"},
+		{Name: "meeting", Category: "meeting", Description: ShortcutInput, Help: "Add a meeting or collaboration task"},
+		{Name: "qa", Category: "qa", Description: ShortcutInput, Help: "Add a QA/testing task"},
+		{Name: "daily", Category: "daily", Description: "Daily Standup", Help: "Add daily standup meeting"},
+	}
 }
 
 // CategoryIcon mapea categorías a iconos
-var CategoryIcon = map[string]string{
-	"tech":     "💻",
-	"meeting":  "🤝",
-	"qa":       "🧪",
-	"doc":      "📚",
-	"planning": "📋",
-	"research": "🔍",
-	"review":   "👀",
-	"deploy":   "🚀",
-	"daily":    "📢",
-	"general":  "📝",
+var CategoryIcon = categoryIcons(DefaultCategories())
+
+// RegisterCategories reemplaza los iconos conocidos por los de las categorías indicadas
+func RegisterCategories(categories []Category) {
+	CategoryIcon = categoryIcons(categories)
+}
+
+// categoryIcons construye el mapa de iconos de una lista de categorías
+func categoryIcons(categories []Category) map[string]string {
+	icons := make(map[string]string, len(categories))
+	for _, category := range categories {
+		if category.Icon != "" {
+			icons[category.Name] = category.Icon
+		}
+	}
+	return icons
 }
 
 // GetIcon devuelve el icono para una categoría
@@ -68,5 +291,5 @@ func GetIcon(category string) string {
 	if icon, exists := CategoryIcon[category]; exists {
 		return icon
 	}
-	return "📝" // default
+	return DefaultIcon // default
 }